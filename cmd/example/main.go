@@ -0,0 +1,332 @@
+// Command example demonstrates the hof packages.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+	"github.com/stuheiss/go-higher-order-functions/hof/chanops"
+	"github.com/stuheiss/go-higher-order-functions/hof/funcops"
+	"github.com/stuheiss/go-higher-order-functions/hof/seqops"
+	"github.com/stuheiss/go-higher-order-functions/hof/sliceops"
+)
+
+type T = hof.T
+
+func main() {
+	t := sliceops.Range[T](1, 11, 1)
+	words := []string{"pear", "apple", "fig", "kiwi"}
+	fmt.Println("dataset", t)
+	fmt.Println("to/from channel", chanops.FromChan(chanops.ToChan(t)))
+	fmt.Println("array reverse", sliceops.Reverse(t))
+	r := sliceops.Concat(t)
+	sliceops.ReverseInPlace(r)
+	fmt.Println("array reverse in place", r)
+	fmt.Println("array rotate 3", sliceops.Rotate(3, t))
+	fmt.Println("array rotate -13", sliceops.Rotate(-13, t))
+	fmt.Println("array shuffle seeded", sliceops.Shuffle(t, rand.New(rand.NewPCG(1, 2))))
+	fmt.Println("array sample 3 seeded", sliceops.Sample(3, t, rand.New(rand.NewPCG(1, 2))))
+	fmt.Println("channel reservoir sample 3 seeded", chanops.ReservoirSample(3, chanops.ToChan(t), rand.New(rand.NewPCG(1, 2))))
+	fmt.Println("array filter < 5", sliceops.FilterT(func(i T) bool { return i < 5 }, t))
+	fmt.Println("array filter even", sliceops.FilterT(func(i T) bool { return i%2 == 0 }, t))
+	fmt.Println("array remove even", sliceops.RemoveT(func(i T) bool { return i%2 == 0 }, t))
+	fmt.Println("array compact", sliceops.Compact([]string{"a", "", "b", "", "c"}))
+	fmt.Println("array compact by blank", sliceops.CompactBy(func(s string) bool { return strings.TrimSpace(s) == "" }, []string{"a", " ", "b", "\t"}))
+	evens, odds := sliceops.Partition(func(i T) bool { return i%2 == 0 }, t)
+	fmt.Println("array partition even", evens, odds)
+	fmt.Println("array take 3", sliceops.Take(3, t))
+	fmt.Println("array drop 3", sliceops.Drop(3, t))
+	fmt.Println("array take while < 4", sliceops.TakeWhile(func(i T) bool { return i < 4 }, t))
+	fmt.Println("array drop while < 4", sliceops.DropWhile(func(i T) bool { return i < 4 }, t))
+	small, rest := sliceops.Span(func(i T) bool { return i < 4 }, t)
+	fmt.Println("array span < 4", small, rest)
+	small, rest = sliceops.Break(func(i T) bool { return i > 6 }, t)
+	fmt.Println("array break > 6", small, rest)
+	small, rest = sliceops.SplitAt(3, t)
+	fmt.Println("array split at 3", small, rest)
+	fmt.Println("array map double", sliceops.MapT(func(i T) T { return i * 2 }, t))
+	fmt.Println("array map to string", sliceops.Map(func(i T) string { return fmt.Sprintf("#%d", i) }, t))
+	fmt.Println("array flatmap repeat", sliceops.FlatMap(func(i T) []T { return []T{i, i} }, t))
+	fmt.Println("array flatten", sliceops.Flatten([][]T{{1, 2}, {}, {3}, {4, 5, 6}}))
+	fmt.Println("array flatten deep", sliceops.FlattenDeep([][][]T{{{1, 2}, {3}}, {{4}}, {{5, 6}}}))
+	fmt.Println("array parallel map double", sliceops.PmapT(func(i T) T { return i * 2 }, t))
+	fmt.Println("array parallel map 3 workers square", sliceops.PmapN(3, func(i T) T { return i * i }, t))
+	fmt.Println("channel map double", chanops.FromChan(chanops.MapChanT(func(i T) T { return i * 2 }, chanops.ToChan(t))))
+	fmt.Println("channel map to string", chanops.FromChan(chanops.MapChan(func(i T) string { return fmt.Sprintf("#%d", i) }, chanops.ToChan(t))))
+	fmt.Println("channel buffered map double", chanops.FromChan(chanops.MapChan(func(i T) T { return i * 2 }, chanops.ToChan(t, chanops.WithBuffer(4)), chanops.WithBuffer(4))))
+	ctx, cancel := context.WithCancel(context.Background())
+	firstTwo := chanops.TakeChan(2, chanops.MapChan(func(i T) T { return i * 2 }, chanops.ToChan(t, chanops.WithContext(ctx)), chanops.WithContext(ctx)))
+	fmt.Println("channel cancel after 2", chanops.FromChan(firstTwo))
+	cancel()
+	fmt.Println("channel parallel ordered map square", chanops.FromChan(chanops.MapChanN(3, func(i T) T {
+		time.Sleep(time.Duration(10-i) * time.Millisecond)
+		return i * i
+	}, chanops.ToChan(t))))
+	fmt.Println("channel parallel unordered map square (sorted)", slices.Sorted(slices.Values(chanops.FromChan(chanops.MapChanUnordered(3, func(i T) T { return i * i }, chanops.ToChan(t))))))
+	fmt.Println("channel flatmap repeat", chanops.FromChan(chanops.FlatMapChan(func(i T) []T { return []T{i, i} }, chanops.ToChan([]T{1, 2, 3}))))
+	fmt.Println("channel flatmap stream", chanops.FromChan(chanops.FlatMapChanStream(func(i T) <-chan T { return chanops.ToChan(sliceops.Range(0, i, 1)) }, chanops.ToChan([]T{1, 2, 3}))))
+	fmt.Println("channel parallel unordered then reorder", chanops.FromChan(chanops.Reorder(chanops.MapChanUnordered(3, func(p hof.Pair[int, T]) hof.Pair[int, T] {
+		time.Sleep(time.Duration(10-p.Second) * time.Millisecond)
+		return hof.MakePair(p.First, p.Second*p.Second)
+	}, chanops.EnumerateChan(chanops.ToChan(t))))))
+	fmt.Println("channel filter odd", chanops.FromChan(chanops.FilterChanT(func(i T) bool { return i%2 != 0 }, chanops.ToChan(t))))
+	fmt.Println("channel remove odd", chanops.FromChan(chanops.RemoveChanT(func(i T) bool { return i%2 != 0 }, chanops.ToChan(t))))
+	fmt.Println("array replicate", sliceops.Replicate(3, "ab"))
+	fmt.Println("array repeat by squares", sliceops.RepeatBy(5, func(i int) T { return T(i * i) }))
+	fmt.Println("array range float", sliceops.Range(0, 1, 0.25))
+	fmt.Println("array unfold fibonacci < 100", sliceops.Unfold([2]T{0, 1}, func(s [2]T) (T, [2]T, bool) {
+		return s[0], [2]T{s[1], s[0] + s[1]}, s[0] < 100
+	}))
+	fmt.Println("channel take 3", chanops.FromChan(chanops.TakeChan(3, chanops.ToChan(t))))
+	fmt.Println("channel drop 3", chanops.FromChan(chanops.DropChan(3, chanops.ToChan(t))))
+	fmt.Println("channel take while < 4", chanops.FromChan(chanops.TakeWhileChan(func(i T) bool { return i < 4 }, chanops.ToChan(t))))
+	fmt.Println("channel drop while < 4", chanops.FromChan(chanops.DropWhileChan(func(i T) bool { return i < 4 }, chanops.ToChan(t))))
+	fmt.Println("channel fold sum", chanops.FoldChan(func(x, y T) T { return x + y }, 0, chanops.ToChan(t)))
+	fmt.Println("channel reduce max", firstOf(chanops.ReduceChan(func(x, y T) T { return max(x, y) }, chanops.ToChan(t))))
+	fmt.Println("channel scan running total", chanops.FromChan(chanops.ScanChan(func(x, y T) T { return x + y }, 0, chanops.ToChan(t))))
+	fmt.Println("channel enumerate", chanops.FromChan(chanops.EnumerateChan(chanops.ToChan(words))))
+	fmt.Println("channel zip", chanops.FromChan(chanops.ZipChan(chanops.ToChan(t), chanops.ToChan(words))))
+	fmt.Println("channel merge (sorted)", slices.Sorted(slices.Values(chanops.FromChan(chanops.Merge(chanops.ToChan(t), chanops.ToChan([]T{100, 200}))))))
+	fmt.Println("channel concat", chanops.FromChan(chanops.ConcatChan(chanops.ToChan([]T{1, 2}), chanops.ToChan([]T{3}), chanops.ToChan([]T{4, 5}))))
+	fmt.Println("channel interleave", chanops.FromChan(chanops.InterleaveChan(chanops.ToChan([]T{1, 2, 3}), chanops.ToChan([]T{10}), chanops.ToChan([]T{100, 200}))))
+	deadline, stop := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	fmt.Println("channel first", firstOf(chanops.First(deadline, chanops.ToChan(t))))
+	fmt.Println("channel last", firstOf(chanops.Last(deadline, chanops.ToChan(t))))
+	_, ok := chanops.First(deadline, make(chan T))
+	fmt.Println("channel first of silent channel within 10ms", ok)
+	stop()
+	partial := chanops.ToChan(t)
+	fmt.Println("channel drain 3 then rest", chanops.DrainN(3, partial), chanops.Drain(partial))
+	orDone, stopOrDone := context.WithCancel(context.Background())
+	guarded := chanops.OrDone(orDone, slowChan([]T{1, 2}, time.Hour, []T{3}))
+	fmt.Println("channel or done first 2", <-guarded, <-guarded)
+	stopOrDone()
+	_, ok = <-guarded
+	fmt.Println("channel or done closed after cancel", !ok)
+	streams := chanops.MapChan(func(i T) <-chan T { return chanops.ToChan(sliceops.Replicate(int(i), i)) }, chanops.ToChan([]T{1, 2, 3}))
+	fmt.Println("channel bridge", chanops.FromChan(chanops.Bridge(context.Background(), streams)))
+	gen, stopGen := context.WithCancel(context.Background())
+	counter := T(0)
+	fmt.Println("channel generate 3", chanops.FromChan(chanops.TakeChan(3, chanops.GenerateChan(func() T { counter++; return counter }, chanops.WithContext(gen)))))
+	fmt.Println("channel repeat 3", chanops.FromChan(chanops.TakeChan(3, chanops.RepeatChan("x", chanops.WithContext(gen)))))
+	fmt.Println("channel iterate double 5", chanops.FromChan(chanops.TakeChan(5, chanops.IterateChan(func(i T) T { return i * 2 }, 1, chanops.WithContext(gen)))))
+	stopGen()
+	evenc, oddc := chanops.PartitionChan(func(i T) bool { return i%2 == 0 }, chanops.ToChan(t))
+	odda := make(chan []T)
+	go func() { odda <- chanops.FromChan(oddc) }()
+	fmt.Println("channel partition even", chanops.FromChan(evenc), <-odda)
+	tees := chanops.Tee(chanops.ToChan(t), 2)
+	teeSums := make(chan T)
+	for _, in := range tees {
+		go func(in <-chan T) { teeSums <- chanops.FoldChan(func(x, y T) T { return x + y }, 0, in) }(in)
+	}
+	fmt.Println("channel tee sums", <-teeSums, <-teeSums)
+	fmt.Println("channel batch 3", chanops.FromChan(chanops.Batch(3, chanops.ToChan(t))))
+	fmt.Println("channel batch 5 or 10ms", chanops.FromChan(chanops.BatchTimeout(5, 10*time.Millisecond, slowChan([]T{1, 2}, 50*time.Millisecond, []T{3, 4, 5, 6, 7, 8}))))
+	fmt.Println("channel debounce 10ms", chanops.FromChan(chanops.Debounce(10*time.Millisecond, slowChan([]T{1, 2}, 50*time.Millisecond, []T{3, 4, 5}))))
+	start := time.Now()
+	throttled := chanops.FromChan(chanops.Throttle(100, time.Second, chanops.ToChan([]T{1, 2, 3, 4, 5})))
+	fmt.Println("channel throttle 100/s", throttled, "took >= 40ms:", time.Since(start) >= 40*time.Millisecond)
+	shards := chanops.Shard(func(i T) int { return int(i) }, 3, chanops.ToChan(t))
+	shardBatches := chanops.Merge(sliceops.Map(func(in <-chan T) <-chan []T { return chanops.Batch(10, in) }, shards)...)
+	fmt.Println("channel shard mod 3 (sorted)", slices.SortedFunc(slices.Values(chanops.FromChan(shardBatches)), func(a, b []T) int { return int(a[0] - b[0]) }))
+	fmt.Println("channel to map by first letter", chanops.ToMap(func(s string) string { return s[:1] }, chanops.ToChan(words)))
+	fmt.Println("channel to set", chanops.ToSet(chanops.ToChan([]T{1, 2, 1, 3, 2})))
+	fmt.Println("channel group by mod 3", chanops.GroupByChan(func(i T) T { return i % 3 }, chanops.ToChan(t)))
+	repeats := []T{1, 2, 1, 3, 2, 4, 1, 5, 1}
+	fmt.Println("channel distinct", chanops.FromChan(chanops.Distinct(chanops.ToChan(repeats))))
+	fmt.Println("channel distinct by mod 3", chanops.FromChan(chanops.DistinctBy(func(i T) T { return i % 3 }, chanops.ToChan(t))))
+	fmt.Println("channel distinct recent 2", chanops.FromChan(chanops.DistinctRecentBy(2, func(i T) T { return i }, chanops.ToChan(repeats))))
+	dropOldest := chanops.Buffer(3, chanops.DropOldest, chanops.ToChan(t))
+	time.Sleep(10 * time.Millisecond)
+	fmt.Println("channel buffer 3 drop oldest, slow consumer", chanops.FromChan(dropOldest))
+	dropNewest := chanops.Buffer(3, chanops.DropNewest, chanops.ToChan(t))
+	time.Sleep(10 * time.Millisecond)
+	fmt.Println("channel buffer 3 drop newest, slow consumer", chanops.FromChan(dropNewest))
+	fmt.Println("channel sample every 20ms", chanops.FromChan(chanops.Sample(20*time.Millisecond, slowChan([]T{1, 2, 3}, 50*time.Millisecond, []T{4, 5}))))
+	fmt.Println("channel timeout each 20ms", chanops.FromChan(chanops.TimeoutEach(20*time.Millisecond, slowChan([]T{1}, 50*time.Millisecond, []T{2}))))
+	tries := map[T]int{}
+	flaky := func(i T) (T, error) {
+		tries[i]++
+		if i == 3 || tries[i] < 2 {
+			return 0, errors.New("flaky")
+		}
+		return i * 10, nil
+	}
+	fmt.Println("channel map retry", chanops.FromChan(chanops.MapChanRetry(flaky, chanops.Backoff{Attempts: 3, Initial: time.Millisecond}, chanops.ToChan([]T{1, 2, 3}))))
+	parsed := chanops.MapChanErr(func(s string) (T, error) {
+		var i T
+		_, err := fmt.Sscan(s, &i)
+		return i, err
+	}, chanops.ToResults(chanops.ToChan([]string{"1", "2", "x", "4"})))
+	values, err := chanops.CollectErr(chanops.FilterChanErr(func(i T) bool { return i%2 == 0 }, parsed))
+	fmt.Println("channel map/filter with errors", values, err)
+	prefetched := chanops.Prefetch(5, chanops.ToChan(t))
+	time.Sleep(10 * time.Millisecond)
+	fmt.Println("channel prefetch 5", chanops.FromChan(prefetched))
+	pipeline := chanops.PipelineOf(t).Filter(func(i T) bool { return i%2 == 0 }).Map(func(i T) T { return i * 10 })
+	fmt.Println("pipeline filter even, map x10, batch 2", chanops.BatchPipeline(pipeline, 2).Collect(context.Background()))
+	running := chanops.NewPipeline(chanops.GenerateChan(func() T { return 1 })).Map(func(i T) T { return i * 2 }).Start(context.Background())
+	received := make(chan int)
+	go func() { received <- chanops.Drain(running.Out()) }()
+	time.Sleep(time.Millisecond)
+	fmt.Println("pipeline stop drained", running.Stop(time.Second), <-received > 0)
+	counts := &countHooks{n: map[string]int{}}
+	counted := chanops.PipelineOf(t, chanops.WithHooks(counts)).Filter(func(i T) bool { return i%2 == 0 }).Collect(context.Background())
+	fmt.Println("pipeline hooks", counted, counts.n["FilterChan"], "emitted by filter")
+	fmt.Println("channel find first > 4 of infinite stream", firstOf(chanops.FindChan(context.Background(), func(i T) bool { return i > 4 }, func(ctx context.Context) <-chan T {
+		return chanops.IterateChan(func(i T) T { return i + 1 }, 1, chanops.WithContext(ctx))
+	})))
+	source := func(ctx context.Context) <-chan T { return chanops.ToChan(t, chanops.WithContext(ctx)) }
+	fmt.Println("channel any even", chanops.AnyChan(context.Background(), func(i T) bool { return i%2 == 0 }, source))
+	fmt.Println("channel all even", chanops.AllChan(context.Background(), func(i T) bool { return i%2 == 0 }, source))
+	naturals := chanops.NewPipeline(chanops.IterateChan(func(i T) T { return i + 1 }, 1))
+	fmt.Println("pipeline find first square > 50 of infinite stream", firstOf(naturals.Map(func(i T) T { return i * i }).Find(context.Background(), func(i T) bool { return i > 50 })))
+	fmt.Println("pipeline any > 1000 of infinite stream", naturals.Any(context.Background(), func(i T) bool { return i > 1000 }))
+	fmt.Println("pipeline all < 10 of infinite stream", naturals.All(context.Background(), func(i T) bool { return i < 10 }))
+	fmt.Println("array foldl sum", sliceops.FoldlT(func(x, y T) T { return x + y }, 0, t))
+	fmt.Println("array foldl sub", sliceops.FoldlT(func(x, y T) T { return x - y }, 0, t))
+	fmt.Println("array foldl mult", sliceops.FoldlT(func(x, y T) T { return x * y }, 1, t))
+	fmt.Println("array foldl count to map", sliceops.Foldl(func(m map[string]int, i T) map[string]int {
+		if i%2 == 0 {
+			m["even"]++
+		} else {
+			m["odd"]++
+		}
+		return m
+	}, map[string]int{}, t))
+	fmt.Println("array foldr sum", sliceops.FoldrT(func(x, y T) T { return x + y }, 0, t))
+	fmt.Println("array foldr sub", sliceops.FoldrT(func(x, y T) T { return x - y }, 0, t))
+	fmt.Println("array foldr mult", sliceops.FoldrT(func(x, y T) T { return x * y }, 1, t))
+	fmt.Println("array foldr join", sliceops.Foldr(func(i T, s string) string { return fmt.Sprint(s, i) }, "", t))
+	fmt.Println("array reduce max", firstOf(sliceops.Reduce(func(x, y T) T { return max(x, y) }, t)))
+	fmt.Println("array scanl sum", sliceops.Scanl(func(x, y T) T { return x + y }, 0, t))
+	fmt.Println("array scanr sum", sliceops.Scanr(func(x, y T) T { return x + y }, 0, t))
+	fmt.Println("array map with index", sliceops.MapWithIndex(func(i int, v T) T { return v * T(i) }, t))
+	fmt.Println("array filter with index every 3rd", sliceops.FilterWithIndex(func(i int, _ T) bool { return i%3 == 0 }, t))
+	fmt.Println("array fold with index alternating sum", sliceops.FoldWithIndex(func(z T, i int, v T) T {
+		if i%2 == 0 {
+			return z + v
+		}
+		return z - v
+	}, 0, t))
+	fmt.Println("array intersperse", sliceops.Intersperse(", ", words))
+	fmt.Println("array interleave", sliceops.Interleave([]T{1, 2, 3}, []T{10, 20}, []T{100, 200, 300}))
+	fmt.Println("array interleave longest", sliceops.InterleaveLongest([]T{1, 2, 3}, []T{10, 20}, []T{100, 200, 300}))
+	fmt.Println("array concat", sliceops.Concat([]T{1, 2}, []T{3}, []T{4, 5, 6}))
+	fmt.Println("array enumerate", sliceops.Enumerate(words))
+	fmt.Println("array zip", sliceops.Zip(t, []string{"a", "b", "c"}))
+	fmt.Println("array zipwith add", sliceops.ZipWith(func(x, y T) T { return x + y }, t, sliceops.Reverse(t)))
+	fmt.Println("array zip3", sliceops.Zip3(t, []string{"a", "b", "c"}, []bool{true, false}))
+	fmt.Println("array zip longest", sliceops.ZipLongest([]T{1, 2, 3, 4}, []string{"a", "b"}, 0, "-"))
+	fmt.Println("array pairwise", sliceops.Pairwise([]T{1, 2, 3, 4}))
+	fmt.Println("array adjacent diff", sliceops.AdjacentDiff([]T{1, 4, 9, 16, 25}))
+	nums, letters := sliceops.Unzip(sliceops.Zip(t, []string{"a", "b", "c"}))
+	fmt.Println("array unzip", nums, letters)
+	fmt.Println("array group by mod 3", sliceops.GroupBy(func(i T) T { return i % 3 }, t))
+	fmt.Println("array frequencies", sliceops.Frequencies([]T{3, 1, 3, 2, 1, 1, 4, 2}))
+	fmt.Println("array frequencies by length", sliceops.FrequenciesBy(func(s string) int { return len(s) }, words))
+	fmt.Println("array associate word lengths", sliceops.Associate(func(s string) (string, int) { return s, len(s) }, words))
+	fmt.Println("array key by first letter", sliceops.KeyBy(func(s string) string { return s[:1] }, words))
+	fmt.Println("array chunk 3", sliceops.Chunk(3, t))
+	fmt.Println("array windows 3 step 2", sliceops.Windows(3, 2, t))
+	fmt.Println("array find > 4", firstOf(sliceops.Find(func(i T) bool { return i > 4 }, t)))
+	fmt.Println("array find index > 4", sliceops.FindIndex(func(i T) bool { return i > 4 }, t))
+	fmt.Println("array find last < 4", firstOf(sliceops.FindLast(func(i T) bool { return i < 4 }, t)))
+	fmt.Println("array find last index < 4", sliceops.FindLastIndex(func(i T) bool { return i < 4 }, t))
+	fmt.Println("array any > 9", sliceops.Any(func(i T) bool { return i > 9 }, t))
+	fmt.Println("array all > 0", sliceops.All(func(i T) bool { return i > 0 }, t))
+	fmt.Println("array none > 10", sliceops.None(func(i T) bool { return i > 10 }, t))
+	dups := []T{3, 1, 3, 2, 1, 1, 4, 2}
+	fmt.Println("array unique", sliceops.Unique(dups))
+	fmt.Println("array unique by mod 3", sliceops.UniqueBy(func(i T) T { return i % 3 }, t))
+	fmt.Println("array dedup adjacent", sliceops.DedupAdjacent(dups))
+	fmt.Println("array difference", sliceops.Difference(t, dups))
+	fmt.Println("array intersection", sliceops.Intersection(dups, []T{4, 3, 9}))
+	fmt.Println("array union", sliceops.Union(dups, []T{4, 5, 3, 6}))
+	fmt.Println("array sort by", sliceops.SortBy(func(a, b T) bool { return a < b }, dups))
+	fmt.Println("array sort stable by mod 3", sliceops.SortStableBy(func(a, b T) bool { return a%3 < b%3 }, t))
+	fmt.Println("array permutations", slices.Collect(sliceops.Permutations([]T{1, 2, 3})))
+	fmt.Println("array combinations 2", slices.Collect(sliceops.Combinations(2, []T{1, 2, 3, 4})))
+	fmt.Println("array power set", slices.Collect(sliceops.PowerSet([]T{1, 2, 3})))
+	fmt.Println("array cartesian product", sliceops.CartesianProduct([]T{1, 2}, []string{"a", "b", "c"}))
+	fmt.Println("array cartesian product n", sliceops.CartesianProductN([]T{1, 2}, []T{3}, []T{4, 5}))
+	fmt.Println("array binary search >= 7", firstOf(sliceops.BinarySearchBy(func(i T) bool { return i >= 7 }, t)))
+	fmt.Println("array equal by fold case", sliceops.EqualBy(strings.EqualFold, words, []string{"PEAR", "Apple", "fig", "KiWi"}))
+	fmt.Println("array starts with 1 2", sliceops.StartsWith([]T{1, 2}, t))
+	fmt.Println("array ends with 9 10", sliceops.EndsWith([]T{9, 10}, t))
+	fmt.Println("array contains 7", sliceops.Contains(7, t))
+	fmt.Println("array contains by len 3", sliceops.ContainsBy(func(s string) bool { return len(s) == 3 }, words))
+	fmt.Println("array sum", sliceops.Sum(t))
+	fmt.Println("array product", sliceops.Product(t))
+	fmt.Println("array mean", firstOf(sliceops.Mean(t)))
+	fmt.Println("array min", firstOf(sliceops.Min(t)))
+	fmt.Println("array max", firstOf(sliceops.Max(t)))
+	lo, hi, _ := sliceops.MinMax(dups)
+	fmt.Println("array minmax", lo, hi)
+	fmt.Println("array min string", firstOf(sliceops.Min([]string{"pear", "apple", "fig"})))
+	byLen := func(a, b string) bool { return len(a) < len(b) }
+	fmt.Println("array min by length", firstOf(sliceops.MinBy(byLen, words)))
+	fmt.Println("array max by length", firstOf(sliceops.MaxBy(byLen, words)))
+	isEven := func(i T) bool { return i%2 == 0 }
+	fmt.Println("func compose double then inc", sliceops.MapT(funcops.Compose(func(i T) T { return i + 1 }, func(i T) T { return i * 2 }), t))
+	fmt.Println("func not even", sliceops.FilterT(funcops.Not(isEven), t))
+	fmt.Println("func flip sub", sliceops.FoldlT(funcops.Flip(func(x, y T) T { return x - y }), 0, t))
+	fmt.Println("func const", sliceops.MapT(funcops.Const[T, T](0), t))
+	fmt.Println("func identity", sliceops.MapT(funcops.Identity[T], t))
+	seq := slices.Values(t)
+	fmt.Println("seq range countdown", slices.Collect(seqops.Range(10, 0, -3)))
+	fmt.Println("seq map double", slices.Collect(seqops.Map(func(i T) T { return i * 2 }, seq)))
+	fmt.Println("seq filter even", slices.Collect(seqops.Filter(isEven, seq)))
+	fmt.Println("seq remove even", slices.Collect(seqops.Remove(isEven, seq)))
+	fmt.Println("seq take 3", slices.Collect(seqops.Take(3, seq)))
+	fmt.Println("seq drop 3", slices.Collect(seqops.Drop(3, seq)))
+	fmt.Println("seq foldl sum", seqops.Foldl(func(x, y T) T { return x + y }, 0, seq))
+	fmt.Println("seq to chan to seq", slices.Collect(chanops.ChanToSeq(context.Background(), chanops.FilterChan(isEven, chanops.SeqToChan(context.Background(), seqops.Range[T](1, 11, 1))))))
+	seq2 := slices.All(t)
+	fmt.Println("seq enumerate filter odd index", slices.Collect(seqops.Values(seqops.FilterSeq2(func(i int, _ string) bool { return i%2 != 0 }, seqops.Enumerate(slices.Values(words))))))
+	fmt.Println("seq2 filter even index", slices.Collect(seqops.Values(seqops.FilterSeq2(func(i int, _ T) bool { return i%2 == 0 }, seq2))))
+	fmt.Println("seq2 map scale by index", slices.Collect(seqops.Values(seqops.MapSeq2(func(i int, v T) (int, T) { return i, v * T(i) }, seq2))))
+	fmt.Println("seq2 fold weighted sum", seqops.FoldSeq2(func(z T, i int, v T) T { return z + T(i)*v }, 0, seq2))
+}
+
+// drop the ok flag from a (value, ok) result for printing
+func firstOf[A any](v A, _ bool) A {
+	return v
+}
+
+// send first, pause for d, then send rest, to demo time based stages
+func slowChan(first []T, d time.Duration, rest []T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, v := range first {
+			out <- v
+		}
+		time.Sleep(d)
+		for _, v := range rest {
+			out <- v
+		}
+	}()
+	return out
+}
+
+// count emitted elements per stage
+type countHooks struct {
+	chanops.NopHooks
+	mu sync.Mutex
+	n  map[string]int
+}
+
+func (h *countHooks) OnEmit(stage string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.n[stage]++
+}
@@ -0,0 +1,3 @@
+module github.com/stuheiss/go-higher-order-functions
+
+go 1.23
@@ -0,0 +1,88 @@
+package chanops
+
+import (
+	"time"
+)
+
+// group elements of in into batches of n, flushing the final partial batch
+// when in closes. Panics if n is not positive.
+func Batch[A any](n int, in <-chan A, opts ...Option) <-chan []A {
+	if n <= 0 {
+		panic("chanops: Batch size must be positive")
+	}
+	c := configure("Batch", opts)
+	to := make(chan []A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		batch := make([]A, 0, n)
+		for v := range receiveAll(c, in) {
+			batch = append(batch, v)
+			if len(batch) == n {
+				if !emit(c, to, batch) {
+					return
+				}
+				batch = make([]A, 0, n)
+			}
+		}
+		if len(batch) > 0 && c.ctx.Err() == nil {
+			emit(c, to, batch)
+		}
+	}()
+	return to
+}
+
+// group elements of in into batches of up to n, emitting a batch when it is
+// full or when d has passed since its first element arrived, whichever comes
+// first. The final partial batch is flushed when in closes. Panics if n is
+// not positive.
+func BatchTimeout[A any](n int, d time.Duration, in <-chan A, opts ...Option) <-chan []A {
+	if n <= 0 {
+		panic("chanops: BatchTimeout size must be positive")
+	}
+	c := configure("BatchTimeout", opts)
+	to := make(chan []A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		timer := time.NewTimer(d)
+		timer.Stop()
+		var timeout <-chan time.Time
+		batch := make([]A, 0, n)
+		defer timer.Stop()
+		flush := func() bool {
+			timer.Stop()
+			timeout = nil
+			ok := emit(c, to, batch)
+			batch = make([]A, 0, n)
+			return ok
+		}
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				c.received()
+				batch = append(batch, v)
+				if len(batch) == 1 {
+					timer.Reset(d)
+					timeout = timer.C
+				}
+				if len(batch) == n && !flush() {
+					return
+				}
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+	return to
+}
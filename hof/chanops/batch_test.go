@@ -0,0 +1,91 @@
+package chanops
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		n    int
+		in   []int
+		want [][]int
+	}{
+		{2, []int{}, nil},
+		{2, []int{1, 2, 3, 4}, [][]int{{1, 2}, {3, 4}}},
+		{2, []int{1, 2, 3}, [][]int{{1, 2}, {3}}},
+		{5, []int{1, 2}, [][]int{{1, 2}}},
+	}
+	for _, tt := range tests {
+		got := FromChan(Batch(tt.n, ToChan(tt.in)))
+		if !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("Batch(%d, %v) got %v, want %v", tt.n, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBatchPanicsOnBadSize(t *testing.T) {
+	for _, f := range []func(){
+		func() { Batch(0, ToChan([]int{})) },
+		func() { BatchTimeout(0, time.Second, ToChan([]int{})) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("no panic for size 0")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestBatchTimeoutFlushesOnSize(t *testing.T) {
+	got := FromChan(BatchTimeout(2, time.Hour, ToChan([]int{1, 2, 3})))
+	if want := [][]int{{1, 2}, {3}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestBatchTimeoutFlushesOnTimeout(t *testing.T) {
+	in := make(chan int)
+	out := BatchTimeout(3, 10*time.Millisecond, in)
+	in <- 1
+	// the partial batch goes out once it is d old, without waiting for more
+	select {
+	case b := <-out:
+		if !slices.Equal(b, []int{1}) {
+			t.Errorf("got %v, want [1]", b)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("partial batch not flushed")
+	}
+	close(in)
+	if b, ok := <-out; ok {
+		t.Errorf("got %v after close, want nothing", b)
+	}
+}
+
+func TestBatchStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	stages := []func(context.Context) <-chan []int{
+		func(ctx context.Context) <-chan []int { return Batch(3, naturals(ctx), WithContext(ctx)) },
+		func(ctx context.Context) <-chan []int {
+			return BatchTimeout(3, time.Millisecond, naturals(ctx), WithContext(ctx))
+		},
+	}
+	for i, stage := range stages {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			out := stage(ctx)
+			<-out
+			<-out
+			cancel()
+			for range out {
+			}
+		})
+	}
+}
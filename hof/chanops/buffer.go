@@ -0,0 +1,70 @@
+package chanops
+
+// OverflowPolicy decides what Buffer does when its queue is full.
+type OverflowPolicy int
+
+const (
+	// stop reading from the input until the consumer catches up
+	Block OverflowPolicy = iota
+	// discard the oldest queued element to make room for the new one
+	DropOldest
+	// discard the new element, keeping what is already queued
+	DropNewest
+)
+
+// queue up to n elements between in and a slow consumer, applying policy
+// when the queue is full so a real-time producer can shed load instead of
+// stalling. Panics if n is not positive.
+func Buffer[A any](n int, policy OverflowPolicy, in <-chan A, opts ...Option) <-chan A {
+	if n <= 0 {
+		panic("chanops: Buffer size must be positive")
+	}
+	c := configure("Buffer", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		queue := make([]A, 0, n)
+		for in != nil || len(queue) > 0 {
+			recv := in
+			if len(queue) == n && policy == Block {
+				recv = nil
+			}
+			var out chan<- A
+			var head A
+			if len(queue) > 0 {
+				out = to
+				head = queue[0]
+			}
+			select {
+			case v, ok := <-recv:
+				if !ok {
+					in = nil
+					continue
+				}
+				c.received()
+				switch {
+				case len(queue) < n:
+					queue = append(queue, v)
+				case policy == DropOldest:
+					queue = append(queue[1:], v)
+				}
+			case out <- head:
+				c.emitted()
+				queue = queue[1:]
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// read ahead up to n elements of in into an internal queue, smoothing out
+// bursts between producer and consumer. Panics if n is not positive.
+func Prefetch[A any](n int, in <-chan A, opts ...Option) <-chan A {
+	if n <= 0 {
+		panic("chanops: Prefetch size must be positive")
+	}
+	return Buffer(n, Block, in, renamed("Prefetch", opts)...)
+}
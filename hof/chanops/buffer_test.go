@@ -0,0 +1,106 @@
+package chanops
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBufferPolicies(t *testing.T) {
+	tests := []struct {
+		policy OverflowPolicy
+		want   []int
+	}{
+		{DropOldest, []int{4, 5}},
+		{DropNewest, []int{1, 2}},
+	}
+	for _, tt := range tests {
+		in := make(chan int)
+		out := Buffer(2, tt.policy, in)
+		// nobody reads out yet, so every send past the second overflows
+		for i := 1; i <= 5; i++ {
+			in <- i
+		}
+		close(in)
+		if got := FromChan(out); !slices.Equal(got, tt.want) {
+			t.Errorf("policy %d got %v, want %v", tt.policy, got, tt.want)
+		}
+	}
+}
+
+func TestBufferBlockKeepsEverything(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	if got := FromChan(Buffer(2, Block, ToChan(in))); !slices.Equal(got, in) {
+		t.Errorf("got %v, want %v", got, in)
+	}
+}
+
+func TestBufferPanicsOnBadSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for size 0")
+		}
+	}()
+	Buffer(0, Block, ToChan([]int{}))
+}
+
+func TestBufferStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	for _, policy := range []OverflowPolicy{Block, DropOldest, DropNewest} {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Buffer(3, policy, naturals(ctx), WithContext(ctx))
+		for range 5 {
+			<-out
+		}
+		cancel()
+		for range out {
+		}
+	}
+}
+
+func TestPrefetch(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	if got := FromChan(Prefetch(2, ToChan(in))); !slices.Equal(got, in) {
+		t.Errorf("got %v, want %v", got, in)
+	}
+}
+
+func TestPrefetchReadsAhead(t *testing.T) {
+	from := make(chan int, 5)
+	for i := range 5 {
+		from <- i
+	}
+	out := Prefetch(2, from)
+	// nobody reads out, so the queue fills and the stage stops reading
+	deadline := time.Now().Add(time.Second)
+	for len(from) > 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if n := 5 - len(from); n != 2 {
+		t.Errorf("read ahead %d elements, want 2", n)
+	}
+	close(from)
+	if got := FromChan(out); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestPrefetchStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := Prefetch(3, naturals(ctx), WithContext(ctx))
+	DrainN(5, out)
+	cancel()
+	Drain(out)
+}
+
+func TestPrefetchPanicsOnBadSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for size 0")
+		}
+	}()
+	Prefetch(0, ToChan([]int{}))
+}
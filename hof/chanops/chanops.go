@@ -0,0 +1,585 @@
+// Package chanops provides higher order functions that work with channels,
+// plus utility functions to convert arrays to channels and vice versa.
+//
+// Every stage that produces channels accepts trailing Options. By default
+// outputs are unbuffered, so a pipeline runs in lockstep; pass WithBuffer(n)
+// to let a stage overlap its work with its consumer. Pass WithContext(ctx) to
+// be able to stop a stage early: once ctx is done the stage closes its
+// outputs and its goroutines exit instead of leaking when the consumer walks
+// away. Pass WithHooks(h) to observe a stage, e.g. to count what flows
+// through it, and WithName to tell stages apart in the hooks calls.
+package chanops
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// send array of A to channel, return channel
+func ToChan[A any](in []A, opts ...Option) <-chan A {
+	c := configure("ToChan", opts)
+	out := make(chan A, c.buffer)
+	go func() {
+		defer close(out)
+		defer c.closed()
+		for _, n := range in {
+			if !emit(c, out, n) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// read array of A from channel, return array
+func FromChan[A any](in <-chan A) []A {
+	out := make([]A, 0)
+	for n := range in {
+		out = append(out, n)
+	}
+	return out
+}
+
+// mapchan
+func MapChanT(f func(hof.T) hof.T, from <-chan hof.T, opts ...Option) chan hof.T {
+	c := configure("MapChanT", opts)
+	to := make(chan hof.T, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for {
+			i, e := receive(c, from)
+			if e == false || !emit(c, to, f(i)) {
+				break
+			}
+		}
+	}()
+	return to
+}
+
+// mapchan from type A to type B
+func MapChan[A, B any](f func(A) B, from <-chan A, opts ...Option) <-chan B {
+	c := configure("MapChan", opts)
+	to := make(chan B, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for n := range receiveAll(c, from) {
+			if !emit(c, to, f(n)) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// filterchan
+func FilterChanT(f func(hof.T) bool, from <-chan hof.T, opts ...Option) <-chan hof.T {
+	c := configure("FilterChanT", opts)
+	to := make(chan hof.T, c.buffer)
+	go func(to chan hof.T) {
+		defer close(to)
+		defer c.closed()
+		for n := range receiveAll(c, from) {
+			if f(n) && !emit(c, to, n) {
+				return
+			}
+		}
+	}(to)
+	return to
+}
+
+// filterchan for any type A
+func FilterChan[A any](f func(A) bool, from <-chan A, opts ...Option) <-chan A {
+	c := configure("FilterChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range receiveAll(c, from) {
+			if f(v) && !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// removechan for any type A
+func RemoveChan[A any](f func(A) bool, from <-chan A, opts ...Option) <-chan A {
+	return FilterChan(func(v A) bool { return !f(v) }, from, renamed("RemoveChan", opts)...)
+}
+
+func RemoveChanT(f func(hof.T) bool, from <-chan hof.T, opts ...Option) <-chan hof.T {
+	c := configure("RemoveChanT", opts)
+	to := make(chan hof.T, c.buffer)
+	go func(to chan hof.T) {
+		defer close(to)
+		defer c.closed()
+		for n := range receiveAll(c, from) {
+			if !f(n) && !emit(c, to, n) {
+				return
+			}
+		}
+	}(to)
+	return to
+}
+
+// forward the first n elements of from, then close the output and stop
+// reading. Anything left in from is not consumed, so its producer must be
+// able to stop on its own.
+func TakeChan[A any](n int, from <-chan A, opts ...Option) <-chan A {
+	c := configure("TakeChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for i := 0; i < n; i++ {
+			v, ok := receive(c, from)
+			if !ok || !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// skip the first n elements of from, forward the rest
+func DropChan[A any](n int, from <-chan A, opts ...Option) <-chan A {
+	c := configure("DropChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range receiveAll(c, from) {
+			if n > 0 {
+				n -= 1
+				continue
+			}
+			if !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// forward elements of from while f holds, then close the output and stop
+// reading. The first failing element and anything after it are not
+// forwarded.
+func TakeWhileChan[A any](f func(A) bool, from <-chan A, opts ...Option) <-chan A {
+	c := configure("TakeWhileChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range receiveAll(c, from) {
+			if !f(v) || !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// skip elements of from while f holds, forward the first failing element and
+// everything after it
+func DropWhileChan[A any](f func(A) bool, from <-chan A, opts ...Option) <-chan A {
+	c := configure("DropWhileChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		dropping := true
+		for v := range receiveAll(c, from) {
+			if dropping && f(v) {
+				continue
+			}
+			dropping = false
+			if !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// foldl over a channel, draining it
+func FoldChan[A, B any](f func(B, A) B, z B, in <-chan A) B {
+	for v := range in {
+		z = f(z, v)
+	}
+	return z
+}
+
+// pair each element of in with its index, counting from 0
+func EnumerateChan[A any](in <-chan A, opts ...Option) <-chan hof.Pair[int, A] {
+	i := -1
+	return MapChan(func(v A) hof.Pair[int, A] {
+		i++
+		return hof.MakePair(i, v)
+	}, in, renamed("EnumerateChan", opts)...)
+}
+
+// running foldl over a channel, emitting the accumulator after every
+// element
+func ScanChan[A, B any](f func(B, A) B, z B, in <-chan A, opts ...Option) <-chan B {
+	return MapChan(func(v A) B {
+		z = f(z, v)
+		return z
+	}, in, renamed("ScanChan", opts)...)
+}
+
+// foldl1 over a channel, draining it. The first element is the seed, false
+// if the channel yields nothing.
+func ReduceChan[A any](f func(A, A) A, in <-chan A) (A, bool) {
+	z, ok := <-in
+	if !ok {
+		return z, false
+	}
+	return FoldChan(f, z, in), true
+}
+
+// pair up elements of a and b as they arrive, closing the output when either
+// input closes
+func ZipChan[A, B any](a <-chan A, b <-chan B, opts ...Option) <-chan hof.Pair[A, B] {
+	c := configure("ZipChan", opts)
+	to := make(chan hof.Pair[A, B], c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for {
+			x, ok := receive(c, a)
+			if !ok {
+				return
+			}
+			y, ok := receive(c, b)
+			if !ok || !emit(c, to, hof.MakePair(x, y)) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// fan-in: forward elements from every input as they arrive, closing the
+// output once all inputs have closed
+func Merge[A any](ins ...<-chan A) <-chan A {
+	return MergeWith(nil, ins...)
+}
+
+// Merge with options
+func MergeWith[A any](opts []Option, ins ...<-chan A) <-chan A {
+	c := configure("Merge", opts)
+	to := make(chan A, c.buffer)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan A) {
+			defer wg.Done()
+			for v := range receiveAll(c, in) {
+				if !emit(c, to, v) {
+					return
+				}
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		c.closed()
+		close(to)
+	}()
+	return to
+}
+
+// forward everything from each input in turn, draining one completely
+// before moving to the next
+func ConcatChan[A any](ins ...<-chan A) <-chan A {
+	return ConcatChanWith(nil, ins...)
+}
+
+// ConcatChan with options
+func ConcatChanWith[A any](opts []Option, ins ...<-chan A) <-chan A {
+	c := configure("ConcatChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for _, in := range ins {
+			for v := range receiveAll(c, in) {
+				if !emit(c, to, v) {
+					return
+				}
+			}
+		}
+	}()
+	return to
+}
+
+// take one element from each input in turn, round-robin, dropping inputs
+// from the rotation as they close, until all have closed. A slow input holds
+// up the others; use Merge when arrival order matters more than fairness.
+func InterleaveChan[A any](ins ...<-chan A) <-chan A {
+	return InterleaveChanWith(nil, ins...)
+}
+
+// InterleaveChan with options
+func InterleaveChanWith[A any](opts []Option, ins ...<-chan A) <-chan A {
+	c := configure("InterleaveChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		open := append([]<-chan A(nil), ins...)
+		for len(open) > 0 {
+			next := open[:0]
+			for _, in := range open {
+				v, ok := receive(c, in)
+				if !ok {
+					if c.ctx.Err() != nil {
+						return
+					}
+					continue
+				}
+				if !emit(c, to, v) {
+					return
+				}
+				next = append(next, in)
+			}
+			open = next
+		}
+	}()
+	return to
+}
+
+// split from into the elements that satisfy f and those that don't,
+// evaluating f once per element. Both outputs must be read concurrently, as
+// an unread output blocks the other.
+func PartitionChan[A any](f func(A) bool, from <-chan A, opts ...Option) (<-chan A, <-chan A) {
+	c := configure("PartitionChan", opts)
+	yes := make(chan A, c.buffer)
+	no := make(chan A, c.buffer)
+	go func() {
+		defer close(yes)
+		defer c.closed()
+		defer close(no)
+		defer c.closed()
+		for v := range receiveAll(c, from) {
+			to := no
+			if f(v) {
+				to = yes
+			}
+			if !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return yes, no
+}
+
+// duplicate every element of in to n outputs, closing them all once in
+// closes. Delivery is in lockstep: the next element is not read from in
+// until every output has taken the current one, so all outputs must be read
+// concurrently. When ctx is done mid-element, the outputs that have not
+// taken it yet go without. Panics if n is negative.
+func Tee[A any](in <-chan A, n int, opts ...Option) []<-chan A {
+	if n < 0 {
+		panic("chanops: Tee count must not be negative")
+	}
+	c := configure("Tee", opts)
+	outs := make([]chan A, n)
+	ro := make([]<-chan A, n)
+	for i := range outs {
+		outs[i] = make(chan A, c.buffer)
+		ro[i] = outs[i]
+	}
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				c.closed()
+				close(out)
+			}
+		}()
+		// one send case per output plus ctx, a served case gets a zero
+		// Chan, which select ignores like a nil channel
+		cases := make([]reflect.SelectCase, n+1)
+		cases[n] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c.ctx.Done())}
+		for v := range receiveAll(c, in) {
+			val := reflect.ValueOf(&v).Elem()
+			for i, out := range outs {
+				cases[i] = reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(out), Send: val}
+			}
+			for range outs {
+				i, _, _ := reflect.Select(cases)
+				if i == n {
+					return
+				}
+				cases[i].Chan = reflect.Value{}
+				c.emitted()
+			}
+		}
+	}()
+	return ro
+}
+
+// fan-out by key: route each element of in to output key(v) mod n, so
+// elements with the same key stay in order on the same output. All outputs
+// must be read concurrently, as an unread output blocks the rest. Panics if
+// n is not positive.
+func Shard[A any](key func(A) int, n int, in <-chan A, opts ...Option) []<-chan A {
+	if n <= 0 {
+		panic("chanops: Shard count must be positive")
+	}
+	c := configure("Shard", opts)
+	outs := make([]chan A, n)
+	ro := make([]<-chan A, n)
+	for i := range outs {
+		outs[i] = make(chan A, c.buffer)
+		ro[i] = outs[i]
+	}
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				c.closed()
+				close(out)
+			}
+		}()
+		for v := range receiveAll(c, in) {
+			i := ((key(v) % n) + n) % n
+			if !emit(c, outs[i], v) {
+				return
+			}
+		}
+	}()
+	return ro
+}
+
+// forward each element of in the first time it is seen. Every distinct
+// element is remembered, so memory grows with the number of distinct
+// elements; see DistinctRecentBy for a bounded variant.
+func Distinct[A comparable](in <-chan A, opts ...Option) <-chan A {
+	return DistinctBy(func(v A) A { return v }, in, renamed("Distinct", opts)...)
+}
+
+// forward each element of in whose key has not been seen before
+func DistinctBy[A any, K comparable](key func(A) K, in <-chan A, opts ...Option) <-chan A {
+	c := configure("DistinctBy", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		seen := make(map[K]struct{})
+		for v := range receiveAll(c, in) {
+			k := key(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// forward each element of in whose key is not among the last n distinct
+// keys seen, using memory bounded by n. A key forgotten to make room for
+// newer ones is forwarded again if it reappears. Panics if n is not
+// positive.
+func DistinctRecentBy[A any, K comparable](n int, key func(A) K, in <-chan A, opts ...Option) <-chan A {
+	if n <= 0 {
+		panic("chanops: DistinctRecentBy size must be positive")
+	}
+	c := configure("DistinctRecentBy", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		seen := make(map[K]struct{}, n)
+		recent := make([]K, n)
+		next := 0
+		for v := range receiveAll(c, in) {
+			k := key(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			if len(seen) == n {
+				delete(seen, recent[next])
+			}
+			seen[k] = struct{}{}
+			recent[next] = k
+			next = (next + 1) % n
+			if !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// map each element of from to an array and forward its elements in order
+func FlatMapChan[A, B any](f func(A) []B, from <-chan A, opts ...Option) <-chan B {
+	c := configure("FlatMapChan", opts)
+	to := make(chan B, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range receiveAll(c, from) {
+			for _, w := range f(v) {
+				if !emit(c, to, w) {
+					return
+				}
+			}
+		}
+	}()
+	return to
+}
+
+// map each element of from to a channel and forward everything it yields,
+// draining each channel before reading the next element of from
+func FlatMapChanStream[A, B any](f func(A) <-chan B, from <-chan A, opts ...Option) <-chan B {
+	c := configure("FlatMapChanStream", opts)
+	to := make(chan B, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range receiveAll(c, from) {
+			for w := range each(c.ctx, f(v)) {
+				if !emit(c, to, w) {
+					return
+				}
+			}
+		}
+	}()
+	return to
+}
+
+// or-done: forward elements of in until it closes or ctx is done, so a
+// channel that knows nothing about ctx can be plugged into a cancellable
+// pipeline. ctx takes precedence over any WithContext in opts.
+func OrDone[A any](ctx context.Context, in <-chan A, opts ...Option) <-chan A {
+	c := configure("OrDone", opts)
+	c.ctx = ctx
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range receiveAll(c, in) {
+			if !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// bridge: flatten a channel of channels, draining each inner channel in the
+// order they arrive, until in closes or ctx is done. ctx takes precedence
+// over any WithContext in opts.
+func Bridge[A any](ctx context.Context, in <-chan <-chan A, opts ...Option) <-chan A {
+	return FlatMapChanStream(func(inner <-chan A) <-chan A { return inner }, in, renamed("Bridge", append(opts[:len(opts):len(opts)], WithContext(ctx)))...)
+}
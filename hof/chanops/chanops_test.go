@@ -0,0 +1,212 @@
+package chanops
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+func TestToChanFromChan(t *testing.T) {
+	for _, in := range [][]hof.T{{}, {1}, {1, 2, 3}} {
+		if got := FromChan(ToChan(in)); !slices.Equal(got, in) {
+			t.Errorf("got %v, want %v", got, in)
+		}
+	}
+}
+
+func TestChanStages(t *testing.T) {
+	even := func(i hof.T) bool { return i%2 == 0 }
+	in := []hof.T{1, 2, 3, 4}
+	tests := []struct {
+		name string
+		out  <-chan hof.T
+		want []hof.T
+	}{
+		{"MapChanT", MapChanT(func(i hof.T) hof.T { return i + 1 }, ToChan(in)), []hof.T{2, 3, 4, 5}},
+		{"FilterChanT", FilterChanT(even, ToChan(in)), []hof.T{2, 4}},
+		{"RemoveChanT", RemoveChanT(even, ToChan(in)), []hof.T{1, 3}},
+	}
+	for _, tt := range tests {
+		if got := FromChan(tt.out); !slices.Equal(got, tt.want) {
+			t.Errorf("%s got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMapChanChangesType(t *testing.T) {
+	got := FromChan(MapChan(strconv.Itoa, ToChan([]int{1, 2})))
+	if want := []string{"1", "2"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestStages(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	small := func(i int) bool { return i < 3 }
+	tests := []struct {
+		name  string
+		stage func(<-chan int) <-chan int
+		want  []int
+	}{
+		{"TakeChan", func(c <-chan int) <-chan int { return TakeChan(2, c) }, []int{1, 2}},
+		{"TakeChan 0", func(c <-chan int) <-chan int { return TakeChan(0, c) }, []int{}},
+		{"TakeChan all", func(c <-chan int) <-chan int { return TakeChan(9, c) }, in},
+		{"DropChan", func(c <-chan int) <-chan int { return DropChan(2, c) }, []int{3, 4, 5}},
+		{"DropChan all", func(c <-chan int) <-chan int { return DropChan(9, c) }, []int{}},
+		{"TakeWhileChan", func(c <-chan int) <-chan int { return TakeWhileChan(small, c) }, []int{1, 2}},
+		{"DropWhileChan", func(c <-chan int) <-chan int { return DropWhileChan(small, c) }, []int{3, 4, 5}},
+		{"Distinct", func(c <-chan int) <-chan int { return Distinct(MapChan(func(i int) int { return i % 2 }, c)) }, []int{1, 0}},
+		{"buffered", func(c <-chan int) <-chan int { return MapChan(func(i int) int { return i }, c, WithBuffer(3)) }, in},
+		{"FlatMapChan", func(c <-chan int) <-chan int {
+			return TakeChan(4, FlatMapChan(func(i int) []int { return []int{i, -i} }, c))
+		}, []int{1, -1, 2, -2}},
+		{"ScanChan", func(c <-chan int) <-chan int { return ScanChan(func(z, i int) int { return z + i }, 0, c) }, []int{1, 3, 6, 10, 15}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromChan(tt.stage(ToChan(in))); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTakeChanStopsReading(t *testing.T) {
+	from := make(chan int, 5)
+	for i := range 5 {
+		from <- i
+	}
+	if got := FromChan(TakeChan(2, from)); !slices.Equal(got, []int{0, 1}) {
+		t.Errorf("got %v, want [0 1]", got)
+	}
+	if len(from) != 3 {
+		t.Errorf("%d elements left in from, want 3", len(from))
+	}
+}
+
+func TestTakeWhileChanStopsReading(t *testing.T) {
+	from := make(chan int, 5)
+	for _, v := range []int{1, 2, 3, 1, 2} {
+		from <- v
+	}
+	close(from)
+	if got := FromChan(TakeWhileChan(func(i int) bool { return i < 3 }, from)); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+	// the failing element is consumed, the rest are not
+	if len(from) != 2 {
+		t.Errorf("%d elements left in from, want 2", len(from))
+	}
+}
+
+func TestZipChanStopsAtShorter(t *testing.T) {
+	got := FromChan(ZipChan(ToChan([]int{1, 2, 3}), ToChan([]string{"a", "b"})))
+	want := []hof.Pair[int, string]{hof.MakePair(1, "a"), hof.MakePair(2, "b")}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := FromChan(ZipChan(ToChan([]int{}), ToChan([]string{"a"}))); len(got) != 0 {
+		t.Errorf("empty a got %v", got)
+	}
+}
+
+func TestWithBuffer(t *testing.T) {
+	tests := []struct {
+		name string
+		out  <-chan int
+		want int
+	}{
+		{"ToChan", ToChan([]int{1}, WithBuffer(2)), 2},
+		{"MapChan", MapChan(func(i int) int { return i }, ToChan([]int{1}), WithBuffer(3)), 3},
+		{"unbuffered", MapChan(func(i int) int { return i }, ToChan([]int{1})), 0},
+		{"negative", ToChan([]int{1}, WithBuffer(-1)), 0},
+	}
+	for _, tt := range tests {
+		if got := cap(tt.out); got != tt.want {
+			t.Errorf("%s cap got %d, want %d", tt.name, got, tt.want)
+		}
+		FromChan(tt.out)
+	}
+}
+
+func TestBufferedStageRunsAhead(t *testing.T) {
+	out := MapChan(func(i int) int { return i }, ToChan([]int{1, 2, 3}, WithBuffer(3)), WithBuffer(3))
+	// nobody reads yet, so the stage can only fill its buffer
+	deadline := time.Now().Add(time.Second)
+	for len(out) < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if len(out) != 3 {
+		t.Errorf("%d elements buffered, want 3", len(out))
+	}
+}
+
+func TestStagesStopOnCancel(t *testing.T) {
+	checkLeaks(t)
+	tests := []struct {
+		name  string
+		stage func(context.Context, <-chan int) <-chan int
+	}{
+		{"ToChan", func(ctx context.Context, _ <-chan int) <-chan int { return ToChan(make([]int, 100), WithContext(ctx)) }},
+		{"MapChan", func(ctx context.Context, in <-chan int) <-chan int {
+			return MapChan(func(i int) int { return i }, in, WithContext(ctx))
+		}},
+		{"TakeChan", func(ctx context.Context, in <-chan int) <-chan int { return TakeChan(100, in, WithContext(ctx)) }},
+		{"DropChan", func(ctx context.Context, in <-chan int) <-chan int { return DropChan(1, in, WithContext(ctx)) }},
+		{"TakeWhileChan", func(ctx context.Context, in <-chan int) <-chan int {
+			return TakeWhileChan(func(int) bool { return true }, in, WithContext(ctx))
+		}},
+		{"DropWhileChan", func(ctx context.Context, in <-chan int) <-chan int {
+			return DropWhileChan(func(i int) bool { return i < 1 }, in, WithContext(ctx))
+		}},
+		{"DistinctRecentBy", func(ctx context.Context, in <-chan int) <-chan int {
+			return DistinctRecentBy(2, func(i int) int { return i }, in, WithContext(ctx))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			out := tt.stage(ctx, naturals(ctx))
+			for range 3 {
+				<-out
+			}
+			cancel()
+			for range out {
+			}
+		})
+	}
+}
+
+func TestZipChanStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := ZipChan(naturals(ctx), naturals(ctx), WithContext(ctx))
+	<-out
+	cancel()
+	for range out {
+	}
+}
+
+func TestScanChan(t *testing.T) {
+	cat := func(z string, i int) string { return z + strconv.Itoa(i) }
+	if got, want := FromChan(ScanChan(cat, ">", ToChan([]int{1, 2, 3}))), []string{">1", ">12", ">123"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	// the seed alone is not emitted
+	if got := FromChan(ScanChan(cat, ">", ToChan([]int{}))); len(got) != 0 {
+		t.Errorf("empty input got %v", got)
+	}
+}
+
+func TestScanChanStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := ScanChan(func(z, i int) int { return z + i }, 0, naturals(ctx), WithContext(ctx))
+	DrainN(3, out)
+	cancel()
+	Drain(out)
+}
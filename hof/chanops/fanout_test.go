@@ -0,0 +1,327 @@
+package chanops
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// read every channel concurrently into its own array
+func fromChans[A any](ins []<-chan A) [][]A {
+	out := make([][]A, len(ins))
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for i, in := range ins {
+		go func() {
+			defer wg.Done()
+			out[i] = FromChan(in)
+		}()
+	}
+	wg.Wait()
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	got := FromChan(Merge(ToChan([]int{1, 2}), ToChan([]int{3}), ToChan([]int{})))
+	slices.Sort(got)
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := FromChan(Merge[int]()); len(got) != 0 {
+		t.Errorf("Merge of nothing got %v", got)
+	}
+}
+
+func TestPartitionChan(t *testing.T) {
+	yes, no := PartitionChan(func(i int) bool { return i%2 == 0 }, ToChan([]int{1, 2, 3, 4, 5}))
+	got := fromChans([]<-chan int{yes, no})
+	if !slices.Equal(got[0], []int{2, 4}) || !slices.Equal(got[1], []int{1, 3, 5}) {
+		t.Errorf("got %v, want [[2 4] [1 3 5]]", got)
+	}
+}
+
+func TestTee(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	for _, n := range []int{0, 1, 3} {
+		got := fromChans(Tee(ToChan(in), n))
+		if len(got) != n {
+			t.Fatalf("Tee(%d) made %d outputs", n, len(got))
+		}
+		for i, out := range got {
+			if !slices.Equal(out, in) {
+				t.Errorf("Tee(%d) output %d got %v, want %v", n, i, out, in)
+			}
+		}
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	in := []string{"a", "B", "b", "a", "c", "A"}
+	lower := func(s string) string { return string(s[0] | 0x20) }
+	tests := []struct {
+		name string
+		out  <-chan string
+		want []string
+	}{
+		{"Distinct", Distinct(ToChan(in)), []string{"a", "B", "b", "c", "A"}},
+		{"DistinctBy", DistinctBy(lower, ToChan(in)), []string{"a", "B", "c"}},
+		{"DistinctRecentBy 1", DistinctRecentBy(1, lower, ToChan(in)), []string{"a", "B", "a", "c", "A"}},
+		{"DistinctRecentBy 2", DistinctRecentBy(2, lower, ToChan(in)), []string{"a", "B", "c", "A"}},
+	}
+	for _, tt := range tests {
+		if got := FromChan(tt.out); !slices.Equal(got, tt.want) {
+			t.Errorf("%s got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDistinctRecentByPanicsOnBadSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for size 0")
+		}
+	}()
+	DistinctRecentBy(0, func(i int) int { return i }, ToChan([]int{}))
+}
+
+func TestMergeStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := MergeWith([]Option{WithContext(ctx)}, naturals(ctx), naturals(ctx))
+	for range 10 {
+		<-out
+	}
+	cancel()
+	for range out {
+	}
+}
+
+func TestPartitionChanStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	yes, no := PartitionChan(func(i int) bool { return i%2 == 0 }, naturals(ctx), WithContext(ctx))
+	// only yes is read, so the partition stalls on no until cancelled
+	<-yes
+	cancel()
+	fromChans([]<-chan int{yes, no})
+}
+
+func TestTeeStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	outs := Tee(naturals(ctx), 2, WithContext(ctx))
+	// only one output is read, so Tee stalls on the other until cancelled
+	<-outs[0]
+	cancel()
+	fromChans(outs)
+}
+
+func TestFlatMapChanStream(t *testing.T) {
+	upTo := func(i int) <-chan int {
+		out := make([]int, i)
+		for j := range out {
+			out[j] = j
+		}
+		return ToChan(out)
+	}
+	got := FromChan(FlatMapChanStream(upTo, ToChan([]int{2, 0, 3})))
+	if want := []int{0, 1, 0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFlatMapChanStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	flat := FlatMapChan(func(i int) []int { return []int{i, i} }, naturals(ctx), WithContext(ctx))
+	stream := FlatMapChanStream(func(int) <-chan int { return naturals(ctx) }, naturals(ctx), WithContext(ctx))
+	for range 3 {
+		<-flat
+		<-stream
+	}
+	cancel()
+	for range flat {
+	}
+	for range stream {
+	}
+}
+
+func TestConcatChan(t *testing.T) {
+	ins := []<-chan int{ToChan([]int{1, 2, 3}), ToChan([]int{}), ToChan([]int{10})}
+	if got, want := FromChan(ConcatChan(ins...)), []int{1, 2, 3, 10}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := FromChan(ConcatChan[int]()); len(got) != 0 {
+		t.Errorf("ConcatChan of nothing got %v", got)
+	}
+}
+
+func TestConcatChanStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := ConcatChanWith([]Option{WithContext(ctx)}, naturals(ctx), naturals(ctx))
+	for range 3 {
+		<-out
+	}
+	cancel()
+	for range out {
+	}
+}
+
+func TestInterleaveChan(t *testing.T) {
+	ins := []<-chan int{ToChan([]int{1, 2, 3}), ToChan([]int{}), ToChan([]int{10})}
+	if got, want := FromChan(InterleaveChan(ins...)), []int{1, 10, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := FromChan(InterleaveChan[int]()); len(got) != 0 {
+		t.Errorf("InterleaveChan of nothing got %v", got)
+	}
+}
+
+func TestInterleaveChanStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := InterleaveChanWith([]Option{WithContext(ctx)}, naturals(ctx), naturals(ctx))
+	for i := range 6 {
+		if v := <-out; v != i/2 {
+			t.Fatalf("element %d is %d, want %d", i, v, i/2)
+		}
+	}
+	cancel()
+	for range out {
+	}
+}
+
+func TestOrDone(t *testing.T) {
+	if got := FromChan(OrDone(context.Background(), ToChan([]int{1, 2}))); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+}
+
+func TestOrDoneStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	// a producer that knows nothing about ctx, stopped by the test itself
+	quit := make(chan struct{})
+	raw := make(chan int)
+	go func() {
+		defer close(raw)
+		for i := 0; ; i++ {
+			select {
+			case raw <- i:
+			case <-quit:
+				return
+			}
+		}
+	}()
+	out := OrDone(ctx, raw)
+	DrainN(3, out)
+	cancel()
+	Drain(out)
+	close(quit)
+}
+
+func TestBridge(t *testing.T) {
+	streams := ToChan([]<-chan int{ToChan([]int{1, 2}), ToChan([]int{}), ToChan([]int{3})})
+	if got, want := FromChan(Bridge(context.Background(), streams)), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestBridgeStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	streams := make(chan (<-chan int))
+	go func() {
+		defer close(streams)
+		for {
+			select {
+			case streams <- naturals(ctx):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	out := Bridge(ctx, streams)
+	DrainN(3, out)
+	cancel()
+	Drain(out)
+}
+
+func TestShard(t *testing.T) {
+	in := []int{-3, -2, -1, 0, 1, 2, 3, 4}
+	key := func(i int) int { return i }
+	got := fromChans(Shard(key, 3, ToChan(in)))
+	n := 0
+	for i, out := range got {
+		for _, v := range out {
+			if ((v%3)+3)%3 != i {
+				t.Errorf("%d on output %d", v, i)
+			}
+		}
+		// same key stays in input order
+		if !slices.IsSorted(out) {
+			t.Errorf("output %d out of order: %v", i, out)
+		}
+		n += len(out)
+	}
+	if n != len(in) {
+		t.Errorf("got %d elements, want %d", n, len(in))
+	}
+}
+
+func TestShardStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	outs := Shard(func(i int) int { return i }, 2, naturals(ctx), WithContext(ctx))
+	DrainN(1, outs[0])
+	cancel()
+	fromChans(outs)
+}
+
+func TestShardPanicsOnBadCount(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for 0 outputs")
+		}
+	}()
+	Shard(func(i int) int { return i }, 0, ToChan([]int{}))
+}
+
+func TestEnumerateChan(t *testing.T) {
+	got := FromChan(EnumerateChan(ToChan([]string{"a", "b"})))
+	want := []hof.Pair[int, string]{hof.MakePair(0, "a"), hof.MakePair(1, "b")}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestEnumerateChanStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := EnumerateChan(naturals(ctx), WithContext(ctx))
+	DrainN(3, out)
+	cancel()
+	Drain(out)
+}
+
+func TestTeeInterfaceNil(t *testing.T) {
+	got := fromChans(Tee(ToChan([]error{nil}), 2))
+	for _, out := range got {
+		if len(out) != 1 || out[0] != nil {
+			t.Errorf("got %v, want [<nil>]", out)
+		}
+	}
+}
+
+func TestTeePanicsOnNegativeCount(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for -1 outputs")
+		}
+	}()
+	Tee(ToChan([]int{1}), -1)
+}
@@ -0,0 +1,33 @@
+package chanops
+
+// endless stream of f(), one call per element. Pass WithContext to stop it;
+// otherwise its goroutine runs until the process exits.
+func GenerateChan[A any](f func() A, opts ...Option) <-chan A {
+	c := configure("GenerateChan", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for emit(c, to, f()) {
+		}
+	}()
+	return to
+}
+
+// endless stream of v. Pass WithContext to stop it; otherwise its goroutine
+// runs until the process exits.
+func RepeatChan[A any](v A, opts ...Option) <-chan A {
+	return GenerateChan(func() A { return v }, renamed("RepeatChan", opts)...)
+}
+
+// iterate :: (a -> a) -> a -> [a]
+// endless stream of seed, f(seed), f(f(seed)), ... Pass WithContext to stop
+// it; otherwise its goroutine runs until the process exits.
+func IterateChan[A any](f func(A) A, seed A, opts ...Option) <-chan A {
+	next := seed
+	return GenerateChan(func() A {
+		v := next
+		next = f(next)
+		return v
+	}, renamed("IterateChan", opts)...)
+}
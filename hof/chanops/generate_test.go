@@ -0,0 +1,40 @@
+package chanops
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestGenerators(t *testing.T) {
+	checkLeaks(t)
+	n := 0
+	tests := []struct {
+		name  string
+		stage func(context.Context) <-chan int
+		want  []int
+	}{
+		{"GenerateChan", func(ctx context.Context) <-chan int {
+			return GenerateChan(func() int { n++; return n }, WithContext(ctx))
+		}, []int{1, 2, 3}},
+		{"RepeatChan", func(ctx context.Context) <-chan int { return RepeatChan(7, WithContext(ctx)) }, []int{7, 7, 7}},
+		{"IterateChan", func(ctx context.Context) <-chan int {
+			return IterateChan(func(i int) int { return i * 2 }, 1, WithContext(ctx))
+		}, []int{1, 2, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			out := tt.stage(ctx)
+			got := make([]int, 0, 3)
+			for range 3 {
+				got = append(got, <-out)
+			}
+			cancel()
+			Drain(out)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
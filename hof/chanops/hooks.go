@@ -0,0 +1,22 @@
+package chanops
+
+// Hooks observes a stage, e.g. to count its elements or export metrics.
+// Each method gets the name of the stage, see WithName. A stage may call
+// them from several goroutines, so they must be safe for concurrent use
+// and should be quick: they run inline with the stage.
+type Hooks interface {
+	// an element was taken from an input
+	OnReceive(stage string)
+	// an element was delivered on an output
+	OnEmit(stage string)
+	// an output is closing, called just before the close so it is seen
+	// before the consumer sees the output closed
+	OnClose(stage string)
+}
+
+// NopHooks ignores every event. Embed it to implement only some of Hooks.
+type NopHooks struct{}
+
+func (NopHooks) OnReceive(string) {}
+func (NopHooks) OnEmit(string)    {}
+func (NopHooks) OnClose(string)   {}
@@ -0,0 +1,79 @@
+package chanops
+
+import (
+	"sync"
+	"testing"
+)
+
+// count every hooks call per stage
+type countHooks struct {
+	mu                       sync.Mutex
+	received, emitted, close map[string]int
+}
+
+func newCountHooks() *countHooks {
+	return &countHooks{received: map[string]int{}, emitted: map[string]int{}, close: map[string]int{}}
+}
+
+func (h *countHooks) OnReceive(stage string) { h.mu.Lock(); h.received[stage]++; h.mu.Unlock() }
+func (h *countHooks) OnEmit(stage string)    { h.mu.Lock(); h.emitted[stage]++; h.mu.Unlock() }
+func (h *countHooks) OnClose(stage string)   { h.mu.Lock(); h.close[stage]++; h.mu.Unlock() }
+
+func TestHooksCountOnlyStageInputsAndOutputs(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	in := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name  string
+		stage func(<-chan int, ...Option) <-chan int
+	}{
+		{"MapChan", func(in <-chan int, opts ...Option) <-chan int { return MapChan(double, in, opts...) }},
+		{"MapChanN", func(in <-chan int, opts ...Option) <-chan int { return MapChanN(3, double, in, opts...) }},
+		{"MapChanUnordered", func(in <-chan int, opts ...Option) <-chan int { return MapChanUnordered(3, double, in, opts...) }},
+		{"FlatMapChanStream", func(in <-chan int, opts ...Option) <-chan int {
+			return FlatMapChanStream(func(i int) <-chan int { return ToChan([]int{i}) }, in, opts...)
+		}},
+		{"ScanChan", func(in <-chan int, opts ...Option) <-chan int {
+			return ScanChan(func(z, i int) int { return z + i }, 0, in, opts...)
+		}},
+		{"Prefetch", func(in <-chan int, opts ...Option) <-chan int { return Prefetch(2, in, opts...) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newCountHooks()
+			got := FromChan(tt.stage(ToChan(in), WithHooks(h)))
+			if len(got) != len(in) {
+				t.Fatalf("got %d elements, want %d", len(got), len(in))
+			}
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if h.received[tt.name] != 5 || h.emitted[tt.name] != 5 || h.close[tt.name] != 1 {
+				t.Errorf("received %v emitted %v closed %v, want 5, 5 and 1 under %q", h.received, h.emitted, h.close, tt.name)
+			}
+		})
+	}
+}
+
+func TestWithNameOverridesStageName(t *testing.T) {
+	h := newCountHooks()
+	Drain(Prefetch(1, ToChan([]int{1, 2}), WithHooks(h), WithName("mine")))
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.emitted["mine"] != 2 || h.emitted["Prefetch"] != 0 {
+		t.Errorf("emitted %v, want 2 under mine", h.emitted)
+	}
+}
+
+func TestPartitionHooksCloseEachOutput(t *testing.T) {
+	h := newCountHooks()
+	yes, no := PartitionChan(func(i int) bool { return i%2 == 0 }, ToChan([]int{1, 2, 3}), WithHooks(h))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() { defer wg.Done(); Drain(yes) }()
+	Drain(no)
+	wg.Wait()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.close["PartitionChan"] != 2 {
+		t.Errorf("closed %v, want 2", h.close)
+	}
+}
@@ -0,0 +1,41 @@
+package chanops
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// fail t if it ends with more goroutines running than it started with,
+// giving stopped stages a moment to exit
+func checkLeaks(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				t.Errorf("%d goroutines still running", runtime.NumGoroutine()-before)
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
+
+// endless 0, 1, 2, ... stopped by ctx, for stages that must stop early
+func naturals(ctx context.Context) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 0; ; i++ {
+			select {
+			case out <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
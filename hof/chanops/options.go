@@ -0,0 +1,131 @@
+package chanops
+
+import (
+	"context"
+	"iter"
+)
+
+// Option configures a channel stage.
+type Option func(*config)
+
+// settings shared by every stage
+type config struct {
+	buffer int
+	ctx    context.Context
+	hooks  Hooks
+	stage  string
+}
+
+// give the output channels of a stage a buffer of n elements, so the stage
+// can run ahead of its consumer instead of in lockstep with it
+func WithBuffer(n int) Option {
+	return func(c *config) {
+		c.buffer = max(n, 0)
+	}
+}
+
+// stop the stage when ctx is done: it stops reading its inputs, closes its
+// outputs and its goroutines exit, even if nobody is reading
+func WithContext(ctx context.Context) Option {
+	return func(c *config) {
+		c.ctx = ctx
+	}
+}
+
+// report what the stage does to h: every element it receives, every
+// element it emits and the closing of its outputs
+func WithHooks(h Hooks) Option {
+	return func(c *config) {
+		c.hooks = h
+	}
+}
+
+// label the stage in hooks calls, by default the name of the function that
+// built it, e.g. "MapChan"
+func WithName(name string) Option {
+	return func(c *config) {
+		c.stage = name
+	}
+}
+
+// apply opts over the defaults for the stage called name
+func configure(name string, opts []Option) config {
+	c := config{ctx: context.Background(), hooks: NopHooks{}, stage: name}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// opts for a stage built on top of another: name it after the wrapper
+// unless the caller named it already
+func renamed(name string, opts []Option) []Option {
+	return append([]Option{WithName(name)}, opts...)
+}
+
+func (c config) received() { c.hooks.OnReceive(c.stage) }
+func (c config) emitted()  { c.hooks.OnEmit(c.stage) }
+func (c config) closed()   { c.hooks.OnClose(c.stage) }
+
+// send v on to for the stage c, false if its ctx is done first
+func emit[A any](c config, to chan<- A, v A) bool {
+	if !send(c.ctx, to, v) {
+		return false
+	}
+	c.emitted()
+	return true
+}
+
+// receive from in for the stage c, false if in is closed or its ctx is done
+func receive[A any](c config, in <-chan A) (A, bool) {
+	v, ok := recv(c.ctx, in)
+	if ok {
+		c.received()
+	}
+	return v, ok
+}
+
+// range over in for the stage c until it is closed or its ctx is done
+func receiveAll[A any](c config, in <-chan A) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for {
+			v, ok := receive(c, in)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// send v on to, false if ctx is done first
+func send[A any](ctx context.Context, to chan<- A, v A) bool {
+	select {
+	case to <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// receive from in, false if in is closed or ctx is done first
+func recv[A any](ctx context.Context, in <-chan A) (A, bool) {
+	select {
+	case v, ok := <-in:
+		return v, ok
+	case <-ctx.Done():
+		var zero A
+		return zero, false
+	}
+}
+
+// range over in until it is closed or ctx is done
+func each[A any](ctx context.Context, in <-chan A) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
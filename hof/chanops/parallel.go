@@ -0,0 +1,119 @@
+package chanops
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// map f over from on a pool of workers goroutines, delivering results in
+// input order. At most workers elements are in flight at once, counting the
+// one waiting to be delivered. Panics if workers is not positive.
+func MapChanN[A, B any](workers int, f func(A) B, from <-chan A, opts ...Option) <-chan B {
+	if workers <= 0 {
+		panic("chanops: MapChanN workers must be positive")
+	}
+	c := configure("MapChanN", opts)
+	to := make(chan B, c.buffer)
+	type job struct {
+		v   A
+		res chan<- B
+	}
+	jobs := make(chan job)
+	// every element in flight owns a result queued here or held by the
+	// collector, so workers-1 slots keep at most workers in flight
+	pending := make(chan chan B, workers-1)
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				j.res <- f(j.v)
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+		for v := range receiveAll(c, from) {
+			res := make(chan B, 1)
+			if !send(c.ctx, pending, res) || !send(c.ctx, jobs, job{v, res}) {
+				return
+			}
+		}
+	}()
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for res := range each(c.ctx, pending) {
+			v, ok := recv(c.ctx, res)
+			if !ok || !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// map f over from on a pool of workers goroutines, delivering each result as
+// soon as it is ready, in no particular order. Panics if workers is not
+// positive.
+func MapChanUnordered[A, B any](workers int, f func(A) B, from <-chan A, opts ...Option) <-chan B {
+	if workers <= 0 {
+		panic("chanops: MapChanUnordered workers must be positive")
+	}
+	c := configure("MapChanUnordered", opts)
+	to := make(chan B, c.buffer)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for v := range receiveAll(c, from) {
+				if !emit(c, to, f(v)) {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		c.closed()
+		close(to)
+	}()
+	return to
+}
+
+// restore index order to elements tagged with consecutive indices starting
+// at 0, such as EnumerateChan output that went through MapChanUnordered.
+// Out of order elements are held until the gap before them is filled; any
+// still held when in closes are flushed in index order.
+func Reorder[A any](in <-chan hof.Pair[int, A], opts ...Option) <-chan A {
+	c := configure("Reorder", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		pending := make(map[int]A)
+		next := 0
+		for p := range receiveAll(c, in) {
+			pending[p.First] = p.Second
+			for v, ok := pending[next]; ok; v, ok = pending[next] {
+				if !emit(c, to, v) {
+					return
+				}
+				delete(pending, next)
+				next++
+			}
+		}
+		if c.ctx.Err() != nil {
+			return
+		}
+		for _, i := range slices.Sorted(maps.Keys(pending)) {
+			if !emit(c, to, pending[i]) {
+				return
+			}
+		}
+	}()
+	return to
+}
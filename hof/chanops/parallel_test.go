@@ -0,0 +1,160 @@
+package chanops
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// square after a random pause, so workers finish out of order
+func jitterSquare(i int) int {
+	time.Sleep(time.Duration(rand.IntN(200)) * time.Microsecond)
+	return i * i
+}
+
+func squares(n int) []int {
+	out := make([]int, n)
+	for i := range out {
+		out[i] = i * i
+	}
+	return out
+}
+
+func TestMapChanNKeepsOrder(t *testing.T) {
+	in := make([]int, 200)
+	for i := range in {
+		in[i] = i
+	}
+	for _, workers := range []int{1, 2, 8, 300} {
+		got := FromChan(MapChanN(workers, jitterSquare, ToChan(in)))
+		if want := squares(len(in)); !slices.Equal(got, want) {
+			t.Errorf("workers %d: got %v, want %v", workers, got, want)
+		}
+	}
+}
+
+func TestMapChanNStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := MapChanN(4, jitterSquare, naturals(ctx), WithContext(ctx))
+	for i := range 10 {
+		if v := <-out; v != i*i {
+			t.Fatalf("element %d is %d, want %d", i, v, i*i)
+		}
+	}
+	cancel()
+	for range out {
+	}
+}
+
+func TestMapChanNPanicsOnBadWorkers(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for 0 workers")
+		}
+	}()
+	MapChanN(0, jitterSquare, ToChan([]int{1}))
+}
+
+func TestMapChanUnordered(t *testing.T) {
+	in := make([]int, 200)
+	for i := range in {
+		in[i] = i
+	}
+	for _, workers := range []int{1, 4, 300} {
+		got := FromChan(MapChanUnordered(workers, jitterSquare, ToChan(in)))
+		slices.Sort(got)
+		if want := squares(len(in)); !slices.Equal(got, want) {
+			t.Errorf("workers %d: got %v, want %v", workers, got, want)
+		}
+	}
+}
+
+func TestMapChanUnorderedStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := MapChanUnordered(4, jitterSquare, naturals(ctx), WithContext(ctx))
+	for range 10 {
+		<-out
+	}
+	cancel()
+	for range out {
+	}
+}
+
+func TestMapChanUnorderedPanicsOnBadWorkers(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for 0 workers")
+		}
+	}()
+	MapChanUnordered(0, jitterSquare, ToChan([]int{1}))
+}
+
+func TestReorder(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []hof.Pair[int, string]
+		want []string
+	}{
+		{"empty", nil, []string{}},
+		{"in order", []hof.Pair[int, string]{{First: 0, Second: "a"}, {First: 1, Second: "b"}}, []string{"a", "b"}},
+		{"reversed", []hof.Pair[int, string]{{First: 2, Second: "c"}, {First: 1, Second: "b"}, {First: 0, Second: "a"}}, []string{"a", "b", "c"}},
+		{"gap flushed at close", []hof.Pair[int, string]{{First: 3, Second: "d"}, {First: 0, Second: "a"}, {First: 2, Second: "c"}}, []string{"a", "c", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromChan(Reorder(ToChan(tt.in))); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReorderAfterUnordered(t *testing.T) {
+	in := make([]int, 200)
+	for i := range in {
+		in[i] = i
+	}
+	tagged := MapChanUnordered(8, func(p hof.Pair[int, int]) hof.Pair[int, int] {
+		return hof.MakePair(p.First, jitterSquare(p.Second))
+	}, EnumerateChan(ToChan(in)))
+	if got, want := FromChan(Reorder(tagged)), squares(len(in)); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestReorderStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	// index 0 never arrives, so everything waits in Reorder's buffer
+	tagged := MapChan(func(i int) hof.Pair[int, int] { return hof.MakePair(i+1, i) }, naturals(ctx), WithContext(ctx))
+	out := Reorder(tagged, WithContext(ctx))
+	time.Sleep(time.Millisecond)
+	cancel()
+	Drain(out)
+}
+
+func TestMapChanNBoundsInFlight(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var started atomic.Int64
+	out := MapChanN(3, func(i int) int {
+		started.Add(1)
+		return i
+	}, naturals(ctx), WithContext(ctx))
+	// nobody reads out, so only the elements in flight get mapped
+	time.Sleep(20 * time.Millisecond)
+	if n := started.Load(); n > 3 {
+		t.Errorf("%d elements mapped with nobody reading, want at most 3", n)
+	}
+	if v, ok := <-out; !ok || v != 0 {
+		t.Errorf("first result %d %v, want 0 true", v, ok)
+	}
+}
@@ -0,0 +1,179 @@
+package chanops
+
+import (
+	"context"
+	"time"
+)
+
+// Pipeline assembles channel stages in reading order:
+//
+//	out := PipelineOf(xs).Filter(even).Map(double).Take(3).Run(ctx)
+//
+// Nothing starts until Run, which wires ctx into every stage so cancelling
+// it stops the whole chain. Use Start instead for a handle that can shut the
+// pipeline down gracefully. Stages that change the element type cannot be
+// methods in Go, so they are functions instead (MapPipeline, BatchPipeline).
+type Pipeline[A any] struct {
+	opts []Option
+	// the source stops when src is done, every other stage when ctx is
+	build func(src, ctx context.Context) <-chan A
+}
+
+// pipeline reading from src; opts apply to every stage of the pipeline
+func NewPipeline[A any](src <-chan A, opts ...Option) Pipeline[A] {
+	return Pipeline[A]{opts: opts, build: func(srcCtx, _ context.Context) <-chan A {
+		return OrDone(srcCtx, src, opts...)
+	}}
+}
+
+// pipeline reading from an array; opts apply to every stage of the pipeline
+func PipelineOf[A any](in []A, opts ...Option) Pipeline[A] {
+	return Pipeline[A]{opts: opts, build: func(srcCtx, _ context.Context) <-chan A {
+		return ToChan(in, stageOptions(srcCtx, opts)...)
+	}}
+}
+
+// the pipeline's options plus ctx, which takes precedence
+func stageOptions(ctx context.Context, opts []Option) []Option {
+	return append(opts[:len(opts):len(opts)], WithContext(ctx))
+}
+
+// add a stage built from the current output
+func then[A, B any](p Pipeline[A], stage func(in <-chan A, opts []Option) <-chan B) Pipeline[B] {
+	return Pipeline[B]{opts: p.opts, build: func(srcCtx, ctx context.Context) <-chan B {
+		return stage(p.build(srcCtx, ctx), stageOptions(ctx, p.opts))
+	}}
+}
+
+// map stage
+func (p Pipeline[A]) Map(f func(A) A) Pipeline[A] {
+	return MapPipeline(p, f)
+}
+
+// filter stage
+func (p Pipeline[A]) Filter(f func(A) bool) Pipeline[A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan A { return FilterChan(f, in, opts...) })
+}
+
+// remove stage
+func (p Pipeline[A]) Remove(f func(A) bool) Pipeline[A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan A { return RemoveChan(f, in, opts...) })
+}
+
+// take stage
+func (p Pipeline[A]) Take(n int) Pipeline[A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan A { return TakeChan(n, in, opts...) })
+}
+
+// drop stage
+func (p Pipeline[A]) Drop(n int) Pipeline[A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan A { return DropChan(n, in, opts...) })
+}
+
+// take while stage
+func (p Pipeline[A]) TakeWhile(f func(A) bool) Pipeline[A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan A { return TakeWhileChan(f, in, opts...) })
+}
+
+// drop while stage
+func (p Pipeline[A]) DropWhile(f func(A) bool) Pipeline[A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan A { return DropWhileChan(f, in, opts...) })
+}
+
+// ordered parallel map stage on a pool of workers goroutines
+func (p Pipeline[A]) MapN(workers int, f func(A) A) Pipeline[A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan A { return MapChanN(workers, f, in, opts...) })
+}
+
+// start every stage and return the final output, which closes when the
+// input is exhausted or ctx is done
+func (p Pipeline[A]) Run(ctx context.Context) <-chan A {
+	return p.build(ctx, ctx)
+}
+
+// start every stage and return a handle to read the output and stop the
+// pipeline. Cancelling ctx stops it abruptly, like Run.
+func (p Pipeline[A]) Start(ctx context.Context) *Running[A] {
+	ctx, cancel := context.WithCancel(ctx)
+	srcCtx, stopSrc := context.WithCancel(ctx)
+	r := &Running[A]{stopSrc: stopSrc, cancel: cancel, done: make(chan struct{})}
+	last := p.build(srcCtx, ctx)
+	out := make(chan A, configure("Pipeline", p.opts).buffer)
+	go func() {
+		defer close(r.done)
+		defer close(out)
+		for v := range each(ctx, last) {
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	r.out = out
+	return r
+}
+
+// run the pipeline and collect its output into an array
+func (p Pipeline[A]) Collect(ctx context.Context) []A {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	return FromChan(p.Run(ctx))
+}
+
+// run the pipeline until an element satisfies f and return it, then stop
+// every stage. False if the output closes without one or ctx is done.
+func (p Pipeline[A]) Find(ctx context.Context, f func(A) bool) (A, bool) {
+	return FindChan(ctx, f, p.Run)
+}
+
+// run the pipeline until an element satisfies f, then stop every stage
+func (p Pipeline[A]) Any(ctx context.Context, f func(A) bool) bool {
+	_, ok := p.Find(ctx, f)
+	return ok
+}
+
+// run the pipeline until an element fails f, then stop every stage. True if
+// the output closes with every element satisfying f.
+func (p Pipeline[A]) All(ctx context.Context, f func(A) bool) bool {
+	return AllChan(ctx, f, p.Run)
+}
+
+// map stage changing the element type
+func MapPipeline[A, B any](p Pipeline[A], f func(A) B) Pipeline[B] {
+	return then(p, func(in <-chan A, opts []Option) <-chan B { return MapChan(f, in, opts...) })
+}
+
+// batch stage grouping elements into arrays of n
+func BatchPipeline[A any](p Pipeline[A], n int) Pipeline[[]A] {
+	return then(p, func(in <-chan A, opts []Option) <-chan []A { return Batch(n, in, opts...) })
+}
+
+// Running is a started pipeline.
+type Running[A any] struct {
+	out     <-chan A
+	stopSrc context.CancelFunc
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// the pipeline's final output
+func (r *Running[A]) Out() <-chan A {
+	return r.out
+}
+
+// shut the pipeline down gracefully: the source stops producing, elements
+// already inside the pipeline keep flowing to Out, and Stop waits up to
+// timeout for Out to close. Keep reading Out while Stop waits. If the
+// pipeline has not drained in time every stage is cancelled, discarding
+// whatever is still in flight, and Stop returns false.
+func (r *Running[A]) Stop(timeout time.Duration) bool {
+	r.stopSrc()
+	defer r.cancel()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-r.done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
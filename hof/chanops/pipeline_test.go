@@ -0,0 +1,126 @@
+package chanops
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPipelineCollect(t *testing.T) {
+	checkLeaks(t)
+	even := func(i int) bool { return i%2 == 0 }
+	small := func(i int) bool { return i < 4 }
+	double := func(i int) int { return i * 2 }
+	p := PipelineOf([]int{1, 2, 3, 4, 5, 6})
+	tests := []struct {
+		name string
+		p    Pipeline[int]
+		want []int
+	}{
+		{"source", p, []int{1, 2, 3, 4, 5, 6}},
+		{"filter map", p.Filter(even).Map(double), []int{4, 8, 12}},
+		{"remove", p.Remove(even), []int{1, 3, 5}},
+		{"take drop", p.Drop(1).Take(3), []int{2, 3, 4}},
+		{"take while", p.TakeWhile(small), []int{1, 2, 3}},
+		{"drop while", p.DropWhile(small), []int{4, 5, 6}},
+		{"map n", p.MapN(3, double), []int{2, 4, 6, 8, 10, 12}},
+		{"buffered", PipelineOf([]int{1, 2}, WithBuffer(2)).Map(double), []int{2, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.Collect(context.Background()); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPipelineTypeChangingStages(t *testing.T) {
+	p := MapPipeline(PipelineOf([]int{1, 2, 3}), func(i int) float64 { return float64(i) / 2 })
+	if got, want := p.Collect(context.Background()), []float64{0.5, 1, 1.5}; !slices.Equal(got, want) {
+		t.Errorf("MapPipeline got %v, want %v", got, want)
+	}
+	batches := BatchPipeline(PipelineOf([]int{1, 2, 3}), 2).Collect(context.Background())
+	if want := [][]int{{1, 2}, {3}}; !slices.EqualFunc(batches, want, slices.Equal) {
+		t.Errorf("BatchPipeline got %v, want %v", batches, want)
+	}
+}
+
+func TestPipelineRunStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	srcCtx, stopSrc := context.WithCancel(context.Background())
+	defer stopSrc()
+	ctx, cancel := context.WithCancel(context.Background())
+	out := NewPipeline(naturals(srcCtx)).Map(func(i int) int { return i + 1 }).MapN(2, func(i int) int { return i }).Run(ctx)
+	DrainN(5, out)
+	cancel()
+	Drain(out)
+}
+
+func TestPipelineStopDrains(t *testing.T) {
+	checkLeaks(t)
+	srcCtx, stopSrc := context.WithCancel(context.Background())
+	defer stopSrc()
+	r := NewPipeline(naturals(srcCtx)).Map(func(i int) int { return i * 2 }).Start(context.Background())
+	got := make(chan []int)
+	go func() { got <- FromChan(r.Out()) }()
+	time.Sleep(time.Millisecond)
+	if !r.Stop(time.Second) {
+		t.Fatal("Stop timed out with a reader on Out")
+	}
+	// everything that made it out is intact and in order
+	out := <-got
+	for i, v := range out {
+		if v != i*2 {
+			t.Fatalf("element %d is %d, want %d", i, v, i*2)
+		}
+	}
+}
+
+func TestPipelineStartStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	r := PipelineOf([]int{1, 2, 3}).Start(ctx)
+	cancel()
+	Drain(r.Out())
+	if !r.Stop(time.Second) {
+		t.Error("Stop after cancel did not see Out closed")
+	}
+}
+
+func TestPipelineStopTimesOut(t *testing.T) {
+	checkLeaks(t)
+	mapped := make(chan struct{}, 3)
+	r := PipelineOf([]int{1, 2, 3}).Map(func(i int) int {
+		mapped <- struct{}{}
+		return i
+	}).Start(context.Background())
+	// wait for an element to reach the map stage; nobody reads Out, so the
+	// pipeline cannot drain
+	<-mapped
+	if r.Stop(10 * time.Millisecond) {
+		t.Error("Stop reported drained with nobody reading Out")
+	}
+	Drain(r.Out())
+}
+
+func TestPipelineShortCircuit(t *testing.T) {
+	checkLeaks(t)
+	srcCtx, stopSrc := context.WithCancel(context.Background())
+	defer stopSrc()
+	p := NewPipeline(naturals(srcCtx))
+	ctx := context.Background()
+	if v, ok := p.Map(func(i int) int { return i * i }).Find(ctx, func(i int) bool { return i > 50 }); !ok || v != 64 {
+		t.Errorf("Find got %d %v, want 64 true", v, ok)
+	}
+	if !p.Any(ctx, func(i int) bool { return i > 100 }) {
+		t.Error("Any got false")
+	}
+	if p.All(ctx, func(i int) bool { return i < 100 }) {
+		t.Error("All got true")
+	}
+	if !PipelineOf([]int{1, 2}).All(ctx, func(i int) bool { return i > 0 }) {
+		t.Error("All of a finite pipeline got false")
+	}
+}
@@ -0,0 +1,33 @@
+package chanops
+
+import (
+	"math/rand/v2"
+)
+
+// drain channel and return k of its elements chosen uniformly at random,
+// holding at most k elements in memory. Returns fewer than k if the channel
+// yields fewer. Pass a seeded r for reproducible results, or nil to use the
+// global random source.
+func ReservoirSample[A any](k int, in <-chan A, r *rand.Rand) []A {
+	out := make([]A, 0, max(k, 0))
+	n := 0
+	for v := range in {
+		n++
+		if len(out) < k {
+			out = append(out, v)
+			continue
+		}
+		if j := intN(r, n); j < k {
+			out[j] = v
+		}
+	}
+	return out
+}
+
+// random int in [0, n) from r, or from the global source if r is nil
+func intN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
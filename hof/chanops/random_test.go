@@ -0,0 +1,38 @@
+package chanops
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestReservoirSample(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		k, want int
+	}{
+		{-1, 0},
+		{0, 0},
+		{3, 3},
+		{10, 10},
+		{20, 10},
+	}
+	for _, tt := range tests {
+		got := ReservoirSample(tt.k, ToChan(in), rand.New(rand.NewPCG(1, 2)))
+		if len(got) != tt.want {
+			t.Errorf("k %d got %v, want %d elements", tt.k, got, tt.want)
+		}
+		seen := map[int]bool{}
+		for _, v := range got {
+			if !slices.Contains(in, v) || seen[v] {
+				t.Errorf("k %d got %v, want distinct elements of the input", tt.k, got)
+			}
+			seen[v] = true
+		}
+	}
+	a := ReservoirSample(3, ToChan(in), rand.New(rand.NewPCG(1, 2)))
+	b := ReservoirSample(3, ToChan(in), rand.New(rand.NewPCG(1, 2)))
+	if !slices.Equal(a, b) {
+		t.Errorf("same seed gave %v and %v", a, b)
+	}
+}
@@ -0,0 +1,67 @@
+package chanops
+
+import (
+	"errors"
+)
+
+// Result carries either a value or the error that took its place, so
+// failures can travel down a pipeline alongside values.
+type Result[A any] struct {
+	Value A
+	Err   error
+}
+
+// ErrTimeout is sent by TimeoutEach when its input stays silent too long.
+var ErrTimeout = errors.New("chanops: timed out waiting for next element")
+
+// wrap every element of in as a successful Result, to start an error-aware
+// pipeline
+func ToResults[A any](in <-chan A, opts ...Option) <-chan Result[A] {
+	return MapChan(func(v A) Result[A] { return Result[A]{Value: v} }, in, renamed("ToResults", opts)...)
+}
+
+// map a fallible f over the values in from. Results that already carry an
+// error pass through untouched; an error from f replaces its value.
+func MapChanErr[A, B any](f func(A) (B, error), from <-chan Result[A], opts ...Option) <-chan Result[B] {
+	return MapChan(func(r Result[A]) Result[B] {
+		if r.Err != nil {
+			return Result[B]{Err: r.Err}
+		}
+		v, err := f(r.Value)
+		return Result[B]{Value: v, Err: err}
+	}, from, renamed("MapChanErr", opts)...)
+}
+
+// keep the values in from that satisfy f. Results that carry an error always
+// pass through.
+func FilterChanErr[A any](f func(A) bool, from <-chan Result[A], opts ...Option) <-chan Result[A] {
+	c := configure("FilterChanErr", opts)
+	to := make(chan Result[A], c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for r := range receiveAll(c, from) {
+			if (r.Err != nil || f(r.Value)) && !emit(c, to, r) {
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// drain in, returning every successful value in order and the first error
+// seen, if any
+func CollectErr[A any](in <-chan Result[A]) ([]A, error) {
+	out := make([]A, 0)
+	var first error
+	for r := range in {
+		if r.Err != nil {
+			if first == nil {
+				first = r.Err
+			}
+			continue
+		}
+		out = append(out, r.Value)
+	}
+	return out, first
+}
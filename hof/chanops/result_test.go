@@ -0,0 +1,37 @@
+package chanops
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestErrorPipeline(t *testing.T) {
+	parsed := MapChanErr(strconv.Atoi, ToResults(ToChan([]string{"1", "x", "2", "3", "y"})))
+	values, err := CollectErr(FilterChanErr(func(i int) bool { return i != 2 }, parsed))
+	if !slices.Equal(values, []int{1, 3}) {
+		t.Errorf("got %v, want [1 3]", values)
+	}
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) || numErr.Num != "x" {
+		t.Errorf("got error %v, want the first parse error, for x", err)
+	}
+}
+
+func TestMapChanErrPassesErrorsThrough(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	out := MapChanErr(func(i int) (int, error) { calls++; return i, nil }, ToChan([]Result[int]{{Err: boom}, {Value: 1}}))
+	got := FromChan(out)
+	if len(got) != 2 || got[0].Err != boom || got[1].Value != 1 || calls != 1 {
+		t.Errorf("got %+v after %d calls", got, calls)
+	}
+}
+
+func TestCollectErrWithoutErrors(t *testing.T) {
+	values, err := CollectErr(ToResults(ToChan([]int{1, 2})))
+	if err != nil || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("got %v %v", values, err)
+	}
+}
@@ -0,0 +1,62 @@
+package chanops
+
+import (
+	"time"
+)
+
+// Backoff controls how MapChanRetry retries a failing element.
+type Backoff struct {
+	// total tries per element including the first, at least 1
+	Attempts int
+	// wait before the first retry
+	Initial time.Duration
+	// cap on the wait between retries, 0 for no cap
+	Max time.Duration
+	// growth of the wait after each retry, 2 if less than 1
+	Multiplier float64
+}
+
+// wait before retry number i, counting from 0
+func (b Backoff) delay(i int) time.Duration {
+	m := b.Multiplier
+	if m < 1 {
+		m = 2
+	}
+	d := float64(b.Initial)
+	for ; i > 0; i-- {
+		d *= m
+		if b.Max > 0 && d >= float64(b.Max) {
+			return b.Max
+		}
+	}
+	return time.Duration(d)
+}
+
+// map a fallible f over from, retrying each failing element with exponential
+// backoff per policy. Each element yields one Result: the first success, or
+// the last error once the attempts are used up.
+func MapChanRetry[A, B any](f func(A) (B, error), policy Backoff, from <-chan A, opts ...Option) <-chan Result[B] {
+	c := configure("MapChanRetry", opts)
+	to := make(chan Result[B], c.buffer)
+	attempts := max(policy.Attempts, 1)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range receiveAll(c, from) {
+			var r Result[B]
+			for i := 0; i < attempts; i++ {
+				if i > 0 && !sleep(c.ctx, policy.delay(i-1)) {
+					return
+				}
+				r.Value, r.Err = f(v)
+				if r.Err == nil {
+					break
+				}
+			}
+			if !emit(c, to, r) {
+				return
+			}
+		}
+	}()
+	return to
+}
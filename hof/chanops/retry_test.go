@@ -0,0 +1,59 @@
+package chanops
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		b    Backoff
+		i    int
+		want time.Duration
+	}{
+		{Backoff{Initial: time.Millisecond}, 0, time.Millisecond},
+		{Backoff{Initial: time.Millisecond}, 3, 8 * time.Millisecond},
+		{Backoff{Initial: time.Millisecond, Multiplier: 3}, 2, 9 * time.Millisecond},
+		{Backoff{Initial: time.Millisecond, Max: 5 * time.Millisecond}, 10, 5 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if got := tt.b.delay(tt.i); got != tt.want {
+			t.Errorf("%+v delay(%d) got %v, want %v", tt.b, tt.i, got, tt.want)
+		}
+	}
+}
+
+func TestMapChanRetry(t *testing.T) {
+	boom := errors.New("boom")
+	tries := map[int]int{}
+	// element i fails its first i tries
+	f := func(i int) (int, error) {
+		tries[i]++
+		if tries[i] <= i {
+			return 0, boom
+		}
+		return i * 10, nil
+	}
+	got := FromChan(MapChanRetry(f, Backoff{Attempts: 3, Initial: time.Microsecond}, ToChan([]int{0, 2, 5})))
+	if len(got) != 3 {
+		t.Fatalf("got %+v, want 3 results", got)
+	}
+	if got[0].Value != 0 || got[0].Err != nil || got[1].Value != 20 || got[1].Err != nil {
+		t.Errorf("got %+v, want 0 and 20 to succeed", got[:2])
+	}
+	if got[2].Err != boom || tries[5] != 3 {
+		t.Errorf("got %+v after %d tries, want boom after 3", got[2], tries[5])
+	}
+}
+
+func TestMapChanRetryStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	fail := func(int) (int, error) { return 0, errors.New("boom") }
+	out := MapChanRetry(fail, Backoff{Attempts: 100, Initial: time.Hour}, naturals(ctx), WithContext(ctx))
+	time.Sleep(time.Millisecond)
+	cancel()
+	Drain(out)
+}
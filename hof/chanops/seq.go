@@ -0,0 +1,31 @@
+package chanops
+
+import (
+	"context"
+	"iter"
+)
+
+// range over in as an iter.Seq, stopping when in closes or ctx is done.
+// Breaking out of the loop early leaves in unread; cancel its producer or
+// Drain it.
+func ChanToSeq[A any](ctx context.Context, in <-chan A) iter.Seq[A] {
+	return each(ctx, in)
+}
+
+// send every element of seq to the returned channel, stopping seq early if
+// ctx is done. ctx takes precedence over any WithContext in opts.
+func SeqToChan[A any](ctx context.Context, seq iter.Seq[A], opts ...Option) <-chan A {
+	c := configure("SeqToChan", opts)
+	c.ctx = ctx
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		for v := range seq {
+			if !emit(c, to, v) {
+				return
+			}
+		}
+	}()
+	return to
+}
@@ -0,0 +1,61 @@
+package chanops
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestSeqBridges(t *testing.T) {
+	ctx := context.Background()
+	in := []int{1, 2, 3}
+	if got := slices.Collect(ChanToSeq(ctx, ToChan(in))); !slices.Equal(got, in) {
+		t.Errorf("ChanToSeq got %v, want %v", got, in)
+	}
+	if got := FromChan(SeqToChan(ctx, slices.Values(in))); !slices.Equal(got, in) {
+		t.Errorf("SeqToChan got %v, want %v", got, in)
+	}
+}
+
+func TestSeqToChanStopsSeqOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	endless := func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; yield(i); i++ {
+		}
+	}
+	out := SeqToChan(ctx, endless)
+	DrainN(3, out)
+	cancel()
+	Drain(out)
+	<-stopped
+}
+
+func TestChanToSeqStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	n := 0
+	for range ChanToSeq(ctx, make(chan int)) {
+		n++
+	}
+	if n != 0 {
+		t.Errorf("got %d elements from silent channels", n)
+	}
+}
+
+func TestChanToSeqStopsReadingOnBreak(t *testing.T) {
+	from := make(chan int, 5)
+	for i := range 5 {
+		from <- i
+	}
+	for v := range ChanToSeq(context.Background(), from) {
+		if v == 1 {
+			break
+		}
+	}
+	if len(from) != 3 {
+		t.Errorf("%d elements left in from, want 3", len(from))
+	}
+}
@@ -0,0 +1,118 @@
+package chanops
+
+import (
+	"context"
+)
+
+// first element of in, false if in closes empty or ctx is done first
+func First[A any](ctx context.Context, in <-chan A) (A, bool) {
+	return recv(ctx, in)
+}
+
+// last element of in once it closes, false if in closes empty or ctx is done
+// before in closes
+func Last[A any](ctx context.Context, in <-chan A) (A, bool) {
+	var last, zero A
+	found := false
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return last, found
+			}
+			last, found = v, true
+		case <-ctx.Done():
+			return zero, false
+		}
+	}
+}
+
+// first element satisfying f of the channel built by stages, false if it
+// closes without one or ctx is done first. stages gets a context derived from
+// ctx to build its stages WithContext; it is cancelled as soon as the answer
+// is known, so every stage stops producing.
+func FindChan[A any](ctx context.Context, f func(A) bool, stages func(context.Context) <-chan A) (A, bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for v := range each(ctx, stages(ctx)) {
+		if f(v) {
+			return v, true
+		}
+	}
+	var zero A
+	return zero, false
+}
+
+// true if some element of the channel built by stages satisfies f, reading no
+// further than the first one that does and then cancelling the stages like
+// FindChan. False if it closes without one or ctx is done first.
+func AnyChan[A any](ctx context.Context, f func(A) bool, stages func(context.Context) <-chan A) bool {
+	_, ok := FindChan(ctx, f, stages)
+	return ok
+}
+
+// true if every element of the channel built by stages satisfies f, reading
+// no further than the first one that doesn't and then cancelling the stages
+// like FindChan. An empty channel gives true; false if ctx is done before it
+// closes.
+func AllChan[A any](ctx context.Context, f func(A) bool, stages func(context.Context) <-chan A) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for v := range each(ctx, stages(ctx)) {
+		if !f(v) {
+			return false
+		}
+	}
+	return ctx.Err() == nil
+}
+
+// read and discard everything from in until it closes, releasing the
+// goroutines feeding it. Returns how many elements were discarded.
+func Drain[A any](in <-chan A) int {
+	n := 0
+	for range in {
+		n++
+	}
+	return n
+}
+
+// read and discard up to n elements from in, returning how many were
+// discarded. Fewer than n means in closed.
+func DrainN[A any](n int, in <-chan A) int {
+	i := 0
+	for ; i < n; i++ {
+		if _, ok := <-in; !ok {
+			break
+		}
+	}
+	return i
+}
+
+// drain in into a map from key to element. Later elements overwrite earlier
+// ones with the same key.
+func ToMap[A any, K comparable](key func(A) K, in <-chan A) map[K]A {
+	out := make(map[K]A)
+	for v := range in {
+		out[key(v)] = v
+	}
+	return out
+}
+
+// drain in into a set of its distinct elements
+func ToSet[A comparable](in <-chan A) map[A]struct{} {
+	out := make(map[A]struct{})
+	for v := range in {
+		out[v] = struct{}{}
+	}
+	return out
+}
+
+// drain in into buckets by key, keeping arrival order within each bucket
+func GroupByChan[A any, K comparable](key func(A) K, in <-chan A) map[K][]A {
+	out := make(map[K][]A)
+	for v := range in {
+		k := key(v)
+		out[k] = append(out[k], v)
+	}
+	return out
+}
@@ -0,0 +1,169 @@
+package chanops
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFoldAndReduce(t *testing.T) {
+	sub := func(z, i int) int { return z - i }
+	if got := FoldChan(sub, 0, ToChan([]int{1, 2, 3})); got != -6 {
+		t.Errorf("FoldChan got %d, want -6", got)
+	}
+	if got, ok := ReduceChan(sub, ToChan([]int{1, 2, 3})); !ok || got != -4 {
+		t.Errorf("ReduceChan got %d %v, want -4 true", got, ok)
+	}
+	if _, ok := ReduceChan(sub, ToChan([]int{})); ok {
+		t.Error("ReduceChan of empty channel reported ok")
+	}
+}
+
+func TestFirstAndLast(t *testing.T) {
+	ctx := context.Background()
+	if v, ok := First(ctx, ToChan([]int{1, 2})); !ok || v != 1 {
+		t.Errorf("First got %d %v, want 1 true", v, ok)
+	}
+	if v, ok := Last(ctx, ToChan([]int{1, 2})); !ok || v != 2 {
+		t.Errorf("Last got %d %v, want 2 true", v, ok)
+	}
+	if _, ok := First(ctx, ToChan([]int{})); ok {
+		t.Error("First of empty channel reported ok")
+	}
+	if _, ok := Last(ctx, ToChan([]int{})); ok {
+		t.Error("Last of empty channel reported ok")
+	}
+	done, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, ok := Last(done, make(chan int)); ok {
+		t.Error("Last after cancel reported ok")
+	}
+}
+
+func TestFirstStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, ok := First(ctx, make(chan int)); ok {
+		t.Error("First of a silent channel reported ok")
+	}
+}
+
+func TestDrain(t *testing.T) {
+	if n := Drain(ToChan([]int{1, 2, 3})); n != 3 {
+		t.Errorf("Drain got %d, want 3", n)
+	}
+	in := ToChan([]int{1, 2, 3})
+	if n := DrainN(2, in); n != 2 {
+		t.Errorf("DrainN got %d, want 2", n)
+	}
+	if n := DrainN(5, in); n != 1 {
+		t.Errorf("DrainN past close got %d, want 1", n)
+	}
+}
+
+func TestCollectors(t *testing.T) {
+	words := []string{"apple", "avocado", "banana"}
+	initial := func(s string) byte { return s[0] }
+	if got, want := ToMap(initial, ToChan(words)), map[byte]string{'a': "avocado", 'b': "banana"}; !maps.Equal(got, want) {
+		t.Errorf("ToMap got %v, want %v", got, want)
+	}
+	if got := ToSet(ToChan([]int{1, 2, 1})); len(got) != 2 {
+		t.Errorf("ToSet got %v, want 2 elements", got)
+	}
+	got := GroupByChan(initial, ToChan(words))
+	if len(got) != 2 || !slices.Equal(got['a'], []string{"apple", "avocado"}) || !slices.Equal(got['b'], []string{"banana"}) {
+		t.Errorf("GroupByChan got %v", got)
+	}
+}
+
+// stages reading from in, remembering the context they were built with
+func arrayStages(in []int, built *context.Context) func(context.Context) <-chan int {
+	return func(ctx context.Context) <-chan int {
+		*built = ctx
+		return ToChan(in, WithContext(ctx))
+	}
+}
+
+func TestShortCircuit(t *testing.T) {
+	ctx := context.Background()
+	gt := func(n int) func(int) bool { return func(i int) bool { return i > n } }
+	tests := []struct {
+		name string
+		in   []int
+		run  func(func(context.Context) <-chan int) any
+		want any
+	}{
+		{"find", []int{1, 2, 3, 4}, func(stages func(context.Context) <-chan int) any {
+			v, _ := FindChan(ctx, gt(2), stages)
+			return v
+		}, 3},
+		{"find none", []int{1, 2}, func(stages func(context.Context) <-chan int) any {
+			_, ok := FindChan(ctx, gt(9), stages)
+			return ok
+		}, false},
+		{"any", []int{1, 2}, func(stages func(context.Context) <-chan int) any { return AnyChan(ctx, gt(1), stages) }, true},
+		{"any none", []int{1, 2}, func(stages func(context.Context) <-chan int) any { return AnyChan(ctx, gt(5), stages) }, false},
+		{"all", []int{1, 2}, func(stages func(context.Context) <-chan int) any { return AllChan(ctx, gt(0), stages) }, true},
+		{"all empty", []int{}, func(stages func(context.Context) <-chan int) any { return AllChan(ctx, gt(0), stages) }, true},
+		{"not all", []int{1, 2}, func(stages func(context.Context) <-chan int) any { return AllChan(ctx, gt(1), stages) }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var built context.Context
+			if got := tt.run(arrayStages(tt.in, &built)); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if built == nil || built.Err() == nil {
+				t.Error("stages not cancelled once the answer was known")
+			}
+		})
+	}
+}
+
+func TestShortCircuitStopsProducers(t *testing.T) {
+	checkLeaks(t)
+	// an endless stream would never drain, so the match must stop it
+	doubled := func(ctx context.Context) <-chan int {
+		return MapChan(func(i int) int { return i * 2 }, naturals(ctx), WithContext(ctx))
+	}
+	if v, ok := FindChan(context.Background(), func(i int) bool { return i > 10 }, doubled); !ok || v != 12 {
+		t.Errorf("got %d %v, want 12 true", v, ok)
+	}
+	if AllChan(context.Background(), func(i int) bool { return i < 5 }, naturals) {
+		t.Error("AllChan of endless stream reported true")
+	}
+}
+
+func TestShortCircuitStopsReading(t *testing.T) {
+	from := make(chan int, 5)
+	for i := range 5 {
+		from <- i
+	}
+	stages := func(context.Context) <-chan int { return from }
+	if v, ok := FindChan(context.Background(), func(i int) bool { return i > 0 }, stages); !ok || v != 1 {
+		t.Errorf("got %d %v, want 1 true", v, ok)
+	}
+	if len(from) != 3 {
+		t.Errorf("%d elements left in from, want 3", len(from))
+	}
+	if AllChan(context.Background(), func(i int) bool { return i < 3 }, stages) {
+		t.Error("AllChan reported true")
+	}
+	if len(from) != 1 {
+		t.Errorf("%d elements left in from, want 1", len(from))
+	}
+}
+
+func TestShortCircuitFalseOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	silent := func(context.Context) <-chan int { return make(chan int) }
+	if _, ok := FindChan(ctx, func(int) bool { return true }, silent); ok {
+		t.Error("FindChan after cancel reported ok")
+	}
+	if AllChan(ctx, func(int) bool { return true }, silent) {
+		t.Error("AllChan after cancel reported true")
+	}
+}
@@ -0,0 +1,166 @@
+package chanops
+
+import (
+	"context"
+	"time"
+)
+
+// forward only the latest element of in once it has been quiet for d. A
+// pending element is flushed when in closes.
+func Debounce[A any](d time.Duration, in <-chan A, opts ...Option) <-chan A {
+	c := configure("Debounce", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		timer := time.NewTimer(d)
+		timer.Stop()
+		defer timer.Stop()
+		var quiet <-chan time.Time
+		var latest A
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if quiet != nil {
+						emit(c, to, latest)
+					}
+					return
+				}
+				c.received()
+				latest = v
+				timer.Reset(d)
+				quiet = timer.C
+			case <-quiet:
+				quiet = nil
+				if !emit(c, to, latest) {
+					return
+				}
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// forward elements of in at most rate per period, spacing them evenly.
+// Panics if rate or per is not positive.
+func Throttle[A any](rate int, per time.Duration, in <-chan A, opts ...Option) <-chan A {
+	if rate <= 0 || per <= 0 {
+		panic("chanops: Throttle rate and period must be positive")
+	}
+	interval := per / time.Duration(rate)
+	c := configure("Throttle", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		var next time.Time
+		for v := range receiveAll(c, in) {
+			if !sleep(c.ctx, time.Until(next)) || !emit(c, to, v) {
+				return
+			}
+			next = time.Now().Add(interval)
+		}
+	}()
+	return to
+}
+
+// wait for d, false if ctx is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// forward the most recent element of in once per interval, skipping ticks
+// where nothing new arrived. A pending element is flushed when in closes.
+// Panics if interval is not positive.
+func Sample[A any](interval time.Duration, in <-chan A, opts ...Option) <-chan A {
+	if interval <= 0 {
+		panic("chanops: Sample interval must be positive")
+	}
+	c := configure("Sample", opts)
+	to := make(chan A, c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		var latest A
+		fresh := false
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if fresh {
+						emit(c, to, latest)
+					}
+					return
+				}
+				c.received()
+				latest, fresh = v, true
+			case <-ticker.C:
+				if fresh {
+					fresh = false
+					if !emit(c, to, latest) {
+						return
+					}
+				}
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+	return to
+}
+
+// forward elements of in as Results, sending a Result with ErrTimeout if in
+// stays silent for longer than d since the previous element (or since the
+// start). One error is sent per silent gap; the stage keeps waiting and the
+// timer restarts with the next element. Panics if d is not positive.
+func TimeoutEach[A any](d time.Duration, in <-chan A, opts ...Option) <-chan Result[A] {
+	if d <= 0 {
+		panic("chanops: TimeoutEach duration must be positive")
+	}
+	c := configure("TimeoutEach", opts)
+	to := make(chan Result[A], c.buffer)
+	go func() {
+		defer close(to)
+		defer c.closed()
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout := timer.C
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				c.received()
+				if !emit(c, to, Result[A]{Value: v}) {
+					return
+				}
+				timer.Reset(d)
+				timeout = timer.C
+			case <-timeout:
+				timeout = nil
+				if !emit(c, to, Result[A]{Err: ErrTimeout}) {
+					return
+				}
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+	return to
+}
@@ -0,0 +1,146 @@
+package chanops
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDebounceKeepsLatestOfBurst(t *testing.T) {
+	in := make(chan int)
+	out := Debounce(20*time.Millisecond, in)
+	for i := 1; i <= 3; i++ {
+		in <- i
+	}
+	select {
+	case v := <-out:
+		if v != 3 {
+			t.Errorf("got %d, want 3", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("burst not flushed after quiet period")
+	}
+	// a pending element is flushed when in closes
+	in <- 4
+	close(in)
+	if got := FromChan(out); !slices.Equal(got, []int{4}) {
+		t.Errorf("got %v, want [4]", got)
+	}
+}
+
+func TestThrottleSpacesElements(t *testing.T) {
+	start := time.Now()
+	got := FromChan(Throttle(5, 50*time.Millisecond, ToChan([]int{1, 2, 3, 4, 5})))
+	if !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("got %v", got)
+	}
+	// four gaps of 10ms after the first element
+	if d := time.Since(start); d < 40*time.Millisecond {
+		t.Errorf("took %v, want at least 40ms", d)
+	}
+}
+
+func TestThrottlePanicsOnBadRate(t *testing.T) {
+	for _, f := range []func(){
+		func() { Throttle(0, time.Second, ToChan([]int{})) },
+		func() { Throttle(1, 0, ToChan([]int{})) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("no panic for a non-positive rate or period")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestTimingStagesStopOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	src := naturals(ctx)
+	debounced := Debounce(time.Hour, src, WithContext(ctx))
+	throttled := Throttle(1000, time.Second, src, WithContext(ctx))
+	<-throttled
+	<-throttled
+	cancel()
+	for range debounced {
+	}
+	for range throttled {
+	}
+}
+
+func TestSampleFlushesPendingOnClose(t *testing.T) {
+	got := FromChan(Sample(time.Hour, ToChan([]int{1, 2, 3})))
+	if !slices.Equal(got, []int{3}) {
+		t.Errorf("got %v, want [3]", got)
+	}
+}
+
+func TestSampleSkipsQuietTicks(t *testing.T) {
+	in := make(chan int)
+	out := Sample(5*time.Millisecond, in)
+	in <- 1
+	if v := <-out; v != 1 {
+		t.Errorf("got %d, want 1", v)
+	}
+	// nothing new arrives, so nothing is repeated
+	time.Sleep(20 * time.Millisecond)
+	close(in)
+	if got := FromChan(out); len(got) != 0 {
+		t.Errorf("got %v after quiet ticks, want nothing", got)
+	}
+}
+
+func TestSampleStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := Sample(time.Millisecond, naturals(ctx), WithContext(ctx))
+	DrainN(2, out)
+	cancel()
+	Drain(out)
+}
+
+func TestSamplePanicsOnBadInterval(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for interval 0")
+		}
+	}()
+	Sample(0, ToChan([]int{}))
+}
+
+func TestTimeoutEach(t *testing.T) {
+	in := make(chan int)
+	out := TimeoutEach(10*time.Millisecond, in)
+	if r := <-out; !errors.Is(r.Err, ErrTimeout) {
+		t.Errorf("got %+v, want ErrTimeout", r)
+	}
+	in <- 1
+	if r := <-out; r.Err != nil || r.Value != 1 {
+		t.Errorf("got %+v, want value 1", r)
+	}
+	close(in)
+	Drain(out)
+}
+
+func TestTimeoutEachStopsOnCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := TimeoutEach(time.Hour, naturals(ctx), WithContext(ctx))
+	DrainN(2, out)
+	cancel()
+	Drain(out)
+}
+
+func TestTimeoutEachPanicsOnBadDuration(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for duration 0")
+		}
+	}()
+	TimeoutEach(0, ToChan([]int{}))
+}
@@ -0,0 +1,35 @@
+// Package funcops provides function combinators.
+package funcops
+
+// identity :: a -> a
+func Identity[A any](x A) A {
+	return x
+}
+
+// const :: a -> b -> a
+func Const[A, B any](x A) func(B) A {
+	return func(B) A {
+		return x
+	}
+}
+
+// (.) :: (b -> c) -> (a -> b) -> a -> c
+func Compose[A, B, C any](f func(B) C, g func(A) B) func(A) C {
+	return func(x A) C {
+		return f(g(x))
+	}
+}
+
+// flip :: (a -> b -> c) -> b -> a -> c
+func Flip[A, B, C any](f func(A, B) C) func(B, A) C {
+	return func(y B, x A) C {
+		return f(x, y)
+	}
+}
+
+// negate a predicate
+func Not[A any](f func(A) bool) func(A) bool {
+	return func(x A) bool {
+		return !f(x)
+	}
+}
@@ -0,0 +1,46 @@
+package funcops
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestIdentity(t *testing.T) {
+	if got := Identity(42); got != 42 {
+		t.Errorf("got %d, want 42", got)
+	}
+}
+
+func TestConst(t *testing.T) {
+	seven := Const[int, string](7)
+	for _, s := range []string{"", "x"} {
+		if got := seven(s); got != 7 {
+			t.Errorf("Const(7)(%q) got %d, want 7", s, got)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	// applies g then f
+	f := Compose(strconv.Itoa, func(i int) int { return i + 1 })
+	if got := f(41); got != "42" {
+		t.Errorf("got %q, want 42", got)
+	}
+}
+
+func TestFlip(t *testing.T) {
+	sub := func(x, y int) int { return x - y }
+	if got := Flip(sub)(1, 10); got != 9 {
+		t.Errorf("got %d, want 9", got)
+	}
+}
+
+func TestNot(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	odd := Not(even)
+	for _, i := range []int{0, 1, 2, 3} {
+		if odd(i) == even(i) {
+			t.Errorf("Not(even)(%d) = %v", i, odd(i))
+		}
+	}
+}
@@ -0,0 +1,25 @@
+// Package hof provides higher order functions in go: map, filter, remove,
+// foldl, foldr, take, drop and many more.
+//
+// The functions are organized into subpackages so you can import only the
+// part you need:
+//
+//	sliceops  functions that work with arrays
+//	chanops   stages that work with channels, conversions between arrays
+//	          and channels, and a pipeline builder
+//	seqops    lazy variants that work with iter.Seq
+//	funcops   function combinators
+//
+// This package holds what they share: the numeric constraints, Pair and
+// Triple, and the type T.
+//
+// Almost every function is generic. The original functions use the single
+// type T defined here and keep a T suffix where a generic version exists
+// (MapT and Map, FoldlT and Foldl).
+//
+// Set type T to your preference or use the generic versions.
+//
+// This is not idiomatic go. You may find it useful if you prefer functional style.
+package hof
+
+type T int
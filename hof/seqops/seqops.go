@@ -0,0 +1,181 @@
+// Package seqops provides higher order functions that work with iter.Seq, so
+// they compose with range-over-func and the standard library iterators
+// (slices.Values, slices.Collect, maps.Keys, ...).
+//
+// All functions are lazy: nothing is evaluated until the returned sequence is
+// ranged over, and a consumer that stops early stops the whole chain.
+package seqops
+
+import (
+	"iter"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// numbers from start up to but not including end, counting by step. A
+// negative step counts down. Element i is start + i*step, so float steps do
+// not accumulate rounding error. An integer range stops at the limits of N
+// rather than wrapping around. Panics if step is zero.
+func Range[N hof.Number](start, end, step N) iter.Seq[N] {
+	if step == 0 {
+		panic("seqops: Range step must not be zero")
+	}
+	return func(yield func(N) bool) {
+		var prev N
+		for i := 0; ; i++ {
+			v := start + N(i)*step
+			if !((step > 0 && v < end) || (step < 0 && v > end)) {
+				return
+			}
+			// past the limits of N, v wraps around and moves back against step
+			if i > 0 && ((step > 0 && v < prev) || (step < 0 && v > prev)) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+			prev = v
+		}
+	}
+}
+
+// map from type A to type B
+func Map[A, B any](f func(A) B, from iter.Seq[A]) iter.Seq[B] {
+	return func(yield func(B) bool) {
+		for v := range from {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// filter
+func Filter[A any](f func(A) bool, from iter.Seq[A]) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for v := range from {
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// remove
+func Remove[A any](f func(A) bool, from iter.Seq[A]) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for v := range from {
+			if !f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// take the first n elements, then stop pulling from the source
+func Take[A any](n int, from iter.Seq[A]) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range from {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// drop the first n elements
+func Drop[A any](n int, from iter.Seq[A]) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		i := 0
+		for v := range from {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// foldl with an accumulator of type B over elements of type A
+func Foldl[A, B any](f func(B, A) B, z B, xs iter.Seq[A]) B {
+	for x := range xs {
+		z = f(z, x)
+	}
+	return z
+}
+
+// pair each element of sequence with its index
+func Enumerate[A any](from iter.Seq[A]) iter.Seq2[int, A] {
+	return func(yield func(int, A) bool) {
+		i := 0
+		for v := range from {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// map over key/value pairs
+func MapSeq2[K, V, K2, V2 any](f func(K, V) (K2, V2), from iter.Seq2[K, V]) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range from {
+			if !yield(f(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// filter key/value pairs
+func FilterSeq2[K, V any](f func(K, V) bool, from iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range from {
+			if f(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// foldl over key/value pairs
+func FoldSeq2[K, V, B any](f func(B, K, V) B, z B, xs iter.Seq2[K, V]) B {
+	for k, v := range xs {
+		z = f(z, k, v)
+	}
+	return z
+}
+
+// keys of key/value pairs
+func Keys[K, V any](from iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range from {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// values of key/value pairs
+func Values[K, V any](from iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range from {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package seqops
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMapFilterRemove(t *testing.T) {
+	in := slices.Values([]int{1, 2, 3, 4})
+	even := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"map", slices.Collect(Map(func(i int) int { return i * 10 }, in)), []int{10, 20, 30, 40}},
+		{"filter", slices.Collect(Filter(even, in)), []int{2, 4}},
+		{"remove", slices.Collect(Remove(even, in)), []int{1, 3}},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestTakeDrop(t *testing.T) {
+	in := slices.Values([]int{1, 2, 3})
+	tests := []struct {
+		n          int
+		take, drop []int
+	}{
+		{-1, nil, []int{1, 2, 3}},
+		{0, nil, []int{1, 2, 3}},
+		{2, []int{1, 2}, []int{3}},
+		{5, []int{1, 2, 3}, nil},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(Take(tt.n, in)); !slices.Equal(got, tt.take) {
+			t.Errorf("Take(%d) got %v, want %v", tt.n, got, tt.take)
+		}
+		if got := slices.Collect(Drop(tt.n, in)); !slices.Equal(got, tt.drop) {
+			t.Errorf("Drop(%d) got %v, want %v", tt.n, got, tt.drop)
+		}
+	}
+}
+
+func TestTakeStopsPullingFromSource(t *testing.T) {
+	pulled := 0
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	if got, want := slices.Collect(Take(3, naturals)), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if pulled != 3 {
+		t.Errorf("pulled %d elements, want 3", pulled)
+	}
+}
+
+func TestFoldl(t *testing.T) {
+	got := Foldl(func(z, i int) int { return z - i }, 0, slices.Values([]int{1, 2, 3}))
+	if got != -6 {
+		t.Errorf("got %d, want -6", got)
+	}
+}
+
+// key/value pairs in order, unlike ranging over a map
+func pairs(kv ...string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for i := 0; i+1 < len(kv); i += 2 {
+			if !yield(kv[i], kv[i+1]) {
+				return
+			}
+		}
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	in := pairs("a", "1", "b", "22", "c", "333")
+	upper := MapSeq2(func(k, v string) (string, int) { return strings.ToUpper(k), len(v) }, in)
+	if got, want := slices.Collect(Keys(upper)), []string{"A", "B", "C"}; !slices.Equal(got, want) {
+		t.Errorf("MapSeq2 keys got %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(Values(upper)), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("MapSeq2 values got %v, want %v", got, want)
+	}
+	long := FilterSeq2(func(_, v string) bool { return len(v) > 1 }, in)
+	if got, want := slices.Collect(Keys(long)), []string{"b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("FilterSeq2 got %v, want %v", got, want)
+	}
+	joined := FoldSeq2(func(z, k, v string) string { return z + k + "=" + v + ";" }, "", in)
+	if joined != "a=1;b=22;c=333;" {
+		t.Errorf("FoldSeq2 got %q", joined)
+	}
+	if got := FoldSeq2(func(z int, _, _ string) int { return z + 1 }, 0, pairs()); got != 0 {
+		t.Errorf("FoldSeq2 of nothing got %d, want 0", got)
+	}
+}
+
+func TestSeq2StopsPullingFromSource(t *testing.T) {
+	// count the pairs pulled from an endless source
+	pulled := 0
+	endless := func(yield func(int, int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i, i*i) {
+				return
+			}
+		}
+	}
+	evenKey := func(k, _ int) bool { return k%2 == 0 }
+	swap := func(k, v int) (int, int) { return v, k }
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want int
+	}{
+		{"MapSeq2", Keys(MapSeq2(swap, endless)), 3},
+		{"FilterSeq2", Keys(FilterSeq2(evenKey, endless)), 5},
+		{"Keys", Keys(endless), 3},
+		{"Values", Values(endless), 3},
+	}
+	for _, tt := range tests {
+		pulled = 0
+		for range tt.seq {
+			if pulled == tt.want {
+				break
+			}
+		}
+		if pulled != tt.want {
+			t.Errorf("%s pulled %d pairs, want %d", tt.name, pulled, tt.want)
+		}
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	var keys []int
+	var values []string
+	for i, v := range Enumerate(slices.Values([]string{"a", "b", "c"})) {
+		keys = append(keys, i)
+		values = append(values, v)
+	}
+	if !slices.Equal(keys, []int{0, 1, 2}) || !slices.Equal(values, []string{"a", "b", "c"}) {
+		t.Errorf("got %v %v", keys, values)
+	}
+}
+
+func TestEnumerateStopsPullingFromSource(t *testing.T) {
+	pulled := 0
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	for i := range Enumerate(naturals) {
+		if i == 2 {
+			break
+		}
+	}
+	if pulled != 3 {
+		t.Errorf("pulled %d elements, want 3", pulled)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name             string
+		start, end, step int
+		want             []int
+	}{
+		{"up", 1, 5, 1, []int{1, 2, 3, 4}},
+		{"step", 0, 10, 3, []int{0, 3, 6, 9}},
+		{"down", 3, 0, -1, []int{3, 2, 1}},
+		{"empty", 5, 1, 1, nil},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(Range(tt.start, tt.end, tt.step)); !slices.Equal(got, tt.want) {
+			t.Errorf("%s got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	for v := range Range(0, 1<<62, 1) {
+		if v == 2 {
+			break
+		}
+	}
+}
+
+func TestRangeFloatExcludesEnd(t *testing.T) {
+	got := slices.Collect(Range(0.0, 1.0, 0.1))
+	if len(got) != 10 || got[9] >= 1 {
+		t.Errorf("got %v, want 10 elements below 1", got)
+	}
+}
+
+func TestRangeStopsAtLimitsOfSmallIntegers(t *testing.T) {
+	if got, want := slices.Collect(Range[uint8](250, 255, 10)), []uint8{250}; !slices.Equal(got, want) {
+		t.Errorf("uint8 got %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(Range[uint8](0, 255, 100)), []uint8{0, 100, 200}; !slices.Equal(got, want) {
+		t.Errorf("uint8 got %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(Range[int8](0, 120, 100)), []int8{0, 100}; !slices.Equal(got, want) {
+		t.Errorf("int8 got %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(Range[int8](100, -128, -100)), []int8{100, 0, -100}; !slices.Equal(got, want) {
+		t.Errorf("int8 down got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,129 @@
+package sliceops
+
+import (
+	"iter"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// lazily yield every ordering of array, in lexicographic order of element
+// positions. Each permutation is a new array the caller may keep.
+func Permutations[A any](in []A) iter.Seq[[]A] {
+	return func(yield func([]A) bool) {
+		idx := make([]int, len(in))
+		for i := range idx {
+			idx[i] = i
+		}
+		for {
+			if !yield(pick(idx, in)) {
+				return
+			}
+			if !nextPermutation(idx) {
+				return
+			}
+		}
+	}
+}
+
+// advance idx to the next lexicographic permutation, false after the last
+func nextPermutation(idx []int) bool {
+	i := len(idx) - 2
+	for i >= 0 && idx[i] >= idx[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(idx) - 1
+	for idx[j] <= idx[i] {
+		j--
+	}
+	idx[i], idx[j] = idx[j], idx[i]
+	ReverseInPlace(idx[i+1:])
+	return true
+}
+
+// new array of the elements of in at the positions in idx
+func pick[A any](idx []int, in []A) []A {
+	out := make([]A, len(idx))
+	for i, j := range idx {
+		out[i] = in[j]
+	}
+	return out
+}
+
+// lazily yield every k-element subset of array, keeping elements in their
+// original order. Yields nothing if k is negative or larger than the array.
+// Each combination is a new array the caller may keep.
+func Combinations[A any](k int, in []A) iter.Seq[[]A] {
+	return func(yield func([]A) bool) {
+		n := len(in)
+		if k < 0 || k > n {
+			return
+		}
+		idx := make([]int, k)
+		for i := range idx {
+			idx[i] = i
+		}
+		for {
+			if !yield(pick(idx, in)) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && idx[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+		}
+	}
+}
+
+// every pair of an element of a with an element of b, varying b fastest
+func CartesianProduct[A, B any](a []A, b []B) []hof.Pair[A, B] {
+	out := make([]hof.Pair[A, B], 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			out = append(out, hof.MakePair(x, y))
+		}
+	}
+	return out
+}
+
+// every way of picking one element from each array, varying the last array
+// fastest. A single empty combination is returned when no arrays are given.
+func CartesianProductN[A any](xs ...[]A) [][]A {
+	out := [][]A{{}}
+	for _, x := range xs {
+		next := make([][]A, 0, len(out)*len(x))
+		for _, prefix := range out {
+			for _, v := range x {
+				row := make([]A, len(prefix), len(prefix)+1)
+				copy(row, prefix)
+				next = append(next, append(row, v))
+			}
+		}
+		out = next
+	}
+	return out
+}
+
+// lazily yield all 2^len(in) subsets of array, smallest first, keeping
+// elements in their original order. Only one subset is held in memory at a
+// time. Each subset is a new array the caller may keep.
+func PowerSet[A any](in []A) iter.Seq[[]A] {
+	return func(yield func([]A) bool) {
+		for k := 0; k <= len(in); k++ {
+			for c := range Combinations(k, in) {
+				if !yield(c) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package sliceops
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+func TestNextPermutation(t *testing.T) {
+	idx := []int{0, 1, 2}
+	got := [][]int{slices.Clone(idx)}
+	for nextPermutation(idx) {
+		got = append(got, slices.Clone(idx))
+	}
+	want := [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if nextPermutation([]int{}) || nextPermutation([]int{0}) {
+		t.Error("advanced past the only permutation")
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		n, want int
+	}{
+		{0, 1},
+		{1, 1},
+		{3, 6},
+		{5, 120},
+	}
+	for _, tt := range tests {
+		in := make([]int, tt.n)
+		if got := len(slices.Collect(Permutations(in))); got != tt.want {
+			t.Errorf("n %d got %d permutations, want %d", tt.n, got, tt.want)
+		}
+	}
+	got := slices.Collect(Permutations([]string{"a", "b", "c"}))
+	want := [][]string{{"a", "b", "c"}, {"a", "c", "b"}, {"b", "a", "c"}, {"b", "c", "a"}, {"c", "a", "b"}, {"c", "b", "a"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	// order follows positions, not values
+	got = slices.Collect(Permutations([]string{"b", "a"}))
+	if want := [][]string{{"b", "a"}, {"a", "b"}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPermutationsStopEarly(t *testing.T) {
+	n := 0
+	for range Permutations([]int{1, 2, 3, 4}) {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("got %d permutations, want 2", n)
+	}
+}
+
+func TestPermutationsYieldCopies(t *testing.T) {
+	in := []int{1, 2, 3}
+	got := slices.Collect(Permutations(in))
+	got[0][0] = 9
+	if got[1][0] != 1 || in[0] != 1 {
+		t.Errorf("permutations share memory: %v, input %v", got, in)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	in := []int{1, 2, 3, 4}
+	tests := []struct {
+		k    int
+		want [][]int
+	}{
+		{-1, nil},
+		{0, [][]int{{}}},
+		{1, [][]int{{1}, {2}, {3}, {4}}},
+		{2, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{3, [][]int{{1, 2, 3}, {1, 2, 4}, {1, 3, 4}, {2, 3, 4}}},
+		{4, [][]int{{1, 2, 3, 4}}},
+		{5, nil},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(Combinations(tt.k, in)); !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("k %d got %v, want %v", tt.k, got, tt.want)
+		}
+	}
+	if got := slices.Collect(Combinations(0, []int{})); len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("k 0 of empty array got %v, want one empty combination", got)
+	}
+	if got := slices.Collect(Combinations(1, []int{})); len(got) != 0 {
+		t.Errorf("k 1 of empty array got %v", got)
+	}
+}
+
+func TestCombinationsCount(t *testing.T) {
+	in := make([]int, 10)
+	tests := []struct {
+		k, want int
+	}{
+		{0, 1},
+		{3, 120},
+		{5, 252},
+		{9, 10},
+		{10, 1},
+	}
+	for _, tt := range tests {
+		if got := len(slices.Collect(Combinations(tt.k, in))); got != tt.want {
+			t.Errorf("C(10, %d) got %d, want %d", tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestCombinationsStopEarlyAndYieldCopies(t *testing.T) {
+	var got [][]int
+	for c := range Combinations(2, []int{1, 2, 3, 4}) {
+		got = append(got, c)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if want := [][]int{{1, 2}, {1, 3}, {1, 4}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	got[0][0] = 9
+	if got[1][0] != 1 {
+		t.Errorf("combinations share memory: %v", got)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	got := CartesianProduct([]int{1, 2}, []string{"a", "b", "c"})
+	want := []hof.Pair[int, string]{
+		hof.MakePair(1, "a"), hof.MakePair(1, "b"), hof.MakePair(1, "c"),
+		hof.MakePair(2, "a"), hof.MakePair(2, "b"), hof.MakePair(2, "c"),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := CartesianProduct([]int{1, 2}, []string{}); len(got) != 0 {
+		t.Errorf("empty b got %v", got)
+	}
+}
+
+func TestCartesianProductN(t *testing.T) {
+	tests := []struct {
+		in   [][]int
+		want [][]int
+	}{
+		{nil, [][]int{{}}},
+		{[][]int{{1, 2}}, [][]int{{1}, {2}}},
+		{[][]int{{1, 2}, {3}, {4, 5}}, [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}},
+		{[][]int{{1, 2}, {}, {4}}, [][]int{}},
+	}
+	for _, tt := range tests {
+		if got := CartesianProductN(tt.in...); !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("CartesianProductN(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	// rows sharing a prefix do not share memory
+	got := CartesianProductN([]int{1}, []int{2, 3}, []int{4, 5})
+	got[0][0], got[0][1] = 9, 9
+	if got[1][0] != 1 || got[1][1] != 2 {
+		t.Errorf("rows share memory: %v", got)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	got := slices.Collect(PowerSet([]int{1, 2, 3}))
+	want := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := slices.Collect(PowerSet([]int{})); len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("empty array got %v, want one empty subset", got)
+	}
+	if got := len(slices.Collect(PowerSet(make([]int, 10)))); got != 1024 {
+		t.Errorf("got %d subsets, want 1024", got)
+	}
+}
+
+func TestPowerSetStopEarlyAndYieldCopies(t *testing.T) {
+	var got [][]int
+	for s := range PowerSet([]int{1, 2, 3}) {
+		got = append(got, s)
+		if len(got) == 5 {
+			break
+		}
+	}
+	if want := [][]int{{}, {1}, {2}, {3}, {1, 2}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	got[1][0] = 9
+	if got[4][0] != 1 {
+		t.Errorf("subsets share memory: %v", got)
+	}
+}
@@ -0,0 +1,59 @@
+package sliceops
+
+// array with sep inserted between each pair of elements
+func Intersperse[A any](sep A, in []A) []A {
+	if len(in) == 0 {
+		return make([]A, 0)
+	}
+	out := make([]A, 0, 2*len(in)-1)
+	for i, v := range in {
+		if i > 0 {
+			out = append(out, sep)
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+// alternate elements round-robin from each array, stopping when the shortest
+// array is exhausted
+func Interleave[A any](xs ...[]A) []A {
+	if len(xs) == 0 {
+		return make([]A, 0)
+	}
+	n := len(xs[0])
+	for _, x := range xs[1:] {
+		n = min(n, len(x))
+	}
+	out := make([]A, 0, n*len(xs))
+	for i := 0; i < n; i++ {
+		for _, x := range xs {
+			out = append(out, x[i])
+		}
+	}
+	return out
+}
+
+// alternate elements round-robin from each array, skipping arrays once they
+// are exhausted, until every array is exhausted
+func InterleaveLongest[A any](xs ...[]A) []A {
+	n, total := 0, 0
+	for _, x := range xs {
+		n = max(n, len(x))
+		total += len(x)
+	}
+	out := make([]A, 0, total)
+	for i := 0; i < n; i++ {
+		for _, x := range xs {
+			if i < len(x) {
+				out = append(out, x[i])
+			}
+		}
+	}
+	return out
+}
+
+// concatenate arrays into one array, allocating once
+func Concat[A any](xs ...[]A) []A {
+	return Flatten(xs)
+}
@@ -0,0 +1,56 @@
+package sliceops
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		in, want []int
+	}{
+		{nil, []int{}},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2, 3}, []int{1, 0, 2, 0, 3}},
+	}
+	for _, tt := range tests {
+		if got := Intersperse(0, tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("Intersperse(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		in                []([]int)
+		shortest, longest []int
+	}{
+		{nil, []int{}, []int{}},
+		{[][]int{{1, 2}}, []int{1, 2}, []int{1, 2}},
+		{[][]int{{1, 2, 3}, {4, 5}}, []int{1, 4, 2, 5}, []int{1, 4, 2, 5, 3}},
+		{[][]int{{1}, {}, {2, 3}}, []int{}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		if got := Interleave(tt.in...); !slices.Equal(got, tt.shortest) {
+			t.Errorf("Interleave(%v) got %v, want %v", tt.in, got, tt.shortest)
+		}
+		if got := InterleaveLongest(tt.in...); !slices.Equal(got, tt.longest) {
+			t.Errorf("InterleaveLongest(%v) got %v, want %v", tt.in, got, tt.longest)
+		}
+	}
+}
+
+func TestConcat(t *testing.T) {
+	if got := Concat[int](); len(got) != 0 {
+		t.Errorf("Concat() got %v", got)
+	}
+	a := []int{1, 2}
+	got := Concat(a, nil, []int{3})
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	got[0] = 9
+	if a[0] != 1 {
+		t.Error("Concat result shares memory with its input")
+	}
+}
@@ -0,0 +1,29 @@
+package sliceops
+
+import (
+	"slices"
+)
+
+// true if xs and ys have the same length and eq holds for each pair of
+// elements at the same index
+func EqualBy[A any](eq func(a, b A) bool, xs, ys []A) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+	for i := range xs {
+		if !eq(xs[i], ys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// true if in begins with prefix
+func StartsWith[A comparable](prefix, in []A) bool {
+	return len(prefix) <= len(in) && slices.Equal(prefix, in[:len(prefix)])
+}
+
+// true if in ends with suffix
+func EndsWith[A comparable](suffix, in []A) bool {
+	return len(suffix) <= len(in) && slices.Equal(suffix, in[len(in)-len(suffix):])
+}
@@ -0,0 +1,46 @@
+package sliceops
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEqualBy(t *testing.T) {
+	fold := func(a, b string) bool { return strings.EqualFold(a, b) }
+	tests := []struct {
+		xs, ys []string
+		want   bool
+	}{
+		{nil, []string{}, true},
+		{[]string{"Go"}, []string{"gO"}, true},
+		{[]string{"Go"}, []string{"go", "c"}, false},
+		{[]string{"Go", "c"}, []string{"go", "d"}, false},
+	}
+	for _, tt := range tests {
+		if got := EqualBy(fold, tt.xs, tt.ys); got != tt.want {
+			t.Errorf("EqualBy(%v, %v) got %v", tt.xs, tt.ys, got)
+		}
+	}
+}
+
+func TestStartsWithEndsWith(t *testing.T) {
+	in := []int{1, 2, 3}
+	tests := []struct {
+		affix        []int
+		starts, ends bool
+	}{
+		{nil, true, true},
+		{[]int{1}, true, false},
+		{[]int{2, 3}, false, true},
+		{[]int{1, 2, 3}, true, true},
+		{[]int{1, 2, 3, 4}, false, false},
+	}
+	for _, tt := range tests {
+		if got := StartsWith(tt.affix, in); got != tt.starts {
+			t.Errorf("StartsWith(%v) got %v", tt.affix, got)
+		}
+		if got := EndsWith(tt.affix, in); got != tt.ends {
+			t.Errorf("EndsWith(%v) got %v", tt.affix, got)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package sliceops
+
+import (
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// replicate :: Int -> a -> [a]
+func Replicate[A any](n int, v A) []A {
+	return RepeatBy(n, func(int) A { return v })
+}
+
+// array of n elements where element i is f(i)
+func RepeatBy[A any](n int, f func(int) A) []A {
+	out := make([]A, max(n, 0))
+	for i := range out {
+		out[i] = f(i)
+	}
+	return out
+}
+
+// numbers from start up to but not including end, counting by step. A
+// negative step counts down. Element i is start + i*step, so float steps do
+// not accumulate rounding error. An integer range stops at the limits of N
+// rather than wrapping around. Panics if step is zero.
+func Range[N hof.Number](start, end, step N) []N {
+	if step == 0 {
+		panic("sliceops: Range step must not be zero")
+	}
+	out := make([]N, 0)
+	for i := 0; ; i++ {
+		v := start + N(i)*step
+		if !((step > 0 && v < end) || (step < 0 && v > end)) {
+			return out
+		}
+		// past the limits of N, v wraps around and moves back against step
+		if i > 0 && ((step > 0 && v < out[i-1]) || (step < 0 && v > out[i-1])) {
+			return out
+		}
+		out = append(out, v)
+	}
+}
+
+// unfoldr :: (b -> Maybe (a, b)) -> b -> [a]
+// build an array from seed, calling step until it reports false
+func Unfold[S, A any](seed S, step func(S) (A, S, bool)) []A {
+	out := make([]A, 0)
+	for {
+		v, next, ok := step(seed)
+		if !ok {
+			return out
+		}
+		out = append(out, v)
+		seed = next
+	}
+}
@@ -0,0 +1,94 @@
+package sliceops
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReplicate(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{-1, []string{}},
+		{0, []string{}},
+		{3, []string{"x", "x", "x"}},
+	}
+	for _, tt := range tests {
+		if got := Replicate(tt.n, "x"); !slices.Equal(got, tt.want) {
+			t.Errorf("Replicate(%d) got %v, want %v", tt.n, got, tt.want)
+		}
+	}
+	if got, want := RepeatBy(4, func(i int) int { return i * i }), []int{0, 1, 4, 9}; !slices.Equal(got, want) {
+		t.Errorf("RepeatBy got %v, want %v", got, want)
+	}
+	if got := RepeatBy(-2, func(i int) int { return i }); len(got) != 0 {
+		t.Errorf("RepeatBy(-2) got %v", got)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name             string
+		start, end, step int
+		want             []int
+	}{
+		{"up", 1, 5, 1, []int{1, 2, 3, 4}},
+		{"step", 0, 10, 3, []int{0, 3, 6, 9}},
+		{"down", 5, 1, -2, []int{5, 3}},
+		{"empty", 5, 1, 1, []int{}},
+	}
+	for _, tt := range tests {
+		if got := Range(tt.start, tt.end, tt.step); !slices.Equal(got, tt.want) {
+			t.Errorf("%s got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRangePanicsOnZeroStep(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for step 0")
+		}
+	}()
+	Range(0, 1, 0)
+}
+
+func TestUnfold(t *testing.T) {
+	// the digits of n, least significant first
+	digits := func(n int) (int, int, bool) { return n % 10, n / 10, n > 0 }
+	if got, want := Unfold(1234, digits), []int{4, 3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := Unfold(0, digits); got == nil || len(got) != 0 {
+		t.Errorf("got %#v, want empty non-nil", got)
+	}
+}
+
+func TestRangeFloatExcludesEnd(t *testing.T) {
+	got := Range(0.0, 1.0, 0.1)
+	if len(got) != 10 || got[9] >= 1 {
+		t.Errorf("got %v, want 10 elements below 1", got)
+	}
+}
+
+func TestRangeStopsAtLimitsOfSmallIntegers(t *testing.T) {
+	if got, want := Range[uint8](250, 255, 10), []uint8{250}; !slices.Equal(got, want) {
+		t.Errorf("uint8 got %v, want %v", got, want)
+	}
+	if got, want := Range[uint8](0, 255, 100), []uint8{0, 100, 200}; !slices.Equal(got, want) {
+		t.Errorf("uint8 got %v, want %v", got, want)
+	}
+	if got, want := Range[uint8](5, 0, 3), []uint8{}; !slices.Equal(got, want) {
+		t.Errorf("uint8 empty got %v, want %v", got, want)
+	}
+	if got, want := Range[int8](0, 120, 100), []int8{0, 100}; !slices.Equal(got, want) {
+		t.Errorf("int8 got %v, want %v", got, want)
+	}
+	if got, want := Range[int8](100, -128, -100), []int8{100, 0, -100}; !slices.Equal(got, want) {
+		t.Errorf("int8 down got %v, want %v", got, want)
+	}
+	if got := Range[int8](-128, 127, 1); len(got) != 255 || got[254] != 126 {
+		t.Errorf("int8 full range got %d elements ending %d, want 255 ending 126", len(got), got[len(got)-1])
+	}
+}
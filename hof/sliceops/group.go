@@ -0,0 +1,70 @@
+package sliceops
+
+// bucket elements of array by key, keeping their order within each bucket
+func GroupBy[A any, K comparable](key func(A) K, in []A) map[K][]A {
+	out := make(map[K][]A)
+	for _, v := range in {
+		k := key(v)
+		out[k] = append(out[k], v)
+	}
+	return out
+}
+
+// split array into batches of n elements, the last batch possibly smaller.
+// The batches share memory with in. Panics if n is not positive.
+func Chunk[A any](n int, in []A) [][]A {
+	if n <= 0 {
+		panic("sliceops: Chunk size must be positive")
+	}
+	out := make([][]A, 0, (len(in)+n-1)/n)
+	for i := 0; i < len(in); i += n {
+		j := min(i+n, len(in))
+		out = append(out, in[i:j:j])
+	}
+	return out
+}
+
+// sliding windows of size elements, starting every step elements. Only full
+// windows are returned. The windows share memory with in. Panics if size or
+// step is not positive.
+func Windows[A any](size, step int, in []A) [][]A {
+	if size <= 0 || step <= 0 {
+		panic("sliceops: Windows size and step must be positive")
+	}
+	out := make([][]A, 0)
+	for i := 0; i+size <= len(in); i += step {
+		out = append(out, in[i:i+size:i+size])
+	}
+	return out
+}
+
+// count occurrences of each element of array
+func Frequencies[A comparable](in []A) map[A]int {
+	return FrequenciesBy(func(v A) A { return v }, in)
+}
+
+// count elements of array by key
+func FrequenciesBy[A any, K comparable](key func(A) K, in []A) map[K]int {
+	out := make(map[K]int)
+	for _, v := range in {
+		out[key(v)]++
+	}
+	return out
+}
+
+// build a map from the key/value pair f returns for each element of array.
+// Later elements overwrite earlier ones with the same key.
+func Associate[A any, K comparable, V any](f func(A) (K, V), in []A) map[K]V {
+	out := make(map[K]V, len(in))
+	for _, v := range in {
+		k, w := f(v)
+		out[k] = w
+	}
+	return out
+}
+
+// build a map from key to element of array. Later elements overwrite earlier
+// ones with the same key.
+func KeyBy[A any, K comparable](key func(A) K, in []A) map[K]A {
+	return Associate(func(v A) (K, A) { return key(v), v }, in)
+}
@@ -0,0 +1,108 @@
+package sliceops
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	got := GroupBy(func(s string) int { return len(s) }, []string{"a", "bb", "c", "dd", "eee"})
+	want := map[int][]string{1: {"a", "c"}, 2: {"bb", "dd"}, 3: {"eee"}}
+	if !maps.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := GroupBy(func(s string) int { return len(s) }, nil); len(got) != 0 {
+		t.Errorf("got %v for an empty array", got)
+	}
+}
+
+// fail t unless f panics
+func mustPanic(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s did not panic", name)
+		}
+	}()
+	f()
+}
+
+func TestChunk(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		n    int
+		want [][]int
+	}{
+		{1, [][]int{{1}, {2}, {3}, {4}, {5}}},
+		{2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{5, [][]int{{1, 2, 3, 4, 5}}},
+		{9, [][]int{{1, 2, 3, 4, 5}}},
+	}
+	for _, tt := range tests {
+		if got := Chunk(tt.n, in); !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("Chunk(%d) got %v, want %v", tt.n, got, tt.want)
+		}
+	}
+	if got := Chunk(3, []int{}); len(got) != 0 {
+		t.Errorf("Chunk of empty array got %v", got)
+	}
+	// appending to a chunk must not overwrite the next one
+	chunks := Chunk(2, in)
+	_ = append(chunks[0], 99)
+	if in[2] != 3 {
+		t.Errorf("append to a chunk changed the input: %v", in)
+	}
+	mustPanic(t, "Chunk(0)", func() { Chunk(0, in) })
+}
+
+func TestWindows(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		size, step int
+		want       [][]int
+	}{
+		{2, 1, [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+		{2, 2, [][]int{{1, 2}, {3, 4}}},
+		{3, 2, [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{5, 1, [][]int{{1, 2, 3, 4, 5}}},
+		{6, 1, [][]int{}},
+	}
+	for _, tt := range tests {
+		if got := Windows(tt.size, tt.step, in); !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("Windows(%d, %d) got %v, want %v", tt.size, tt.step, got, tt.want)
+		}
+	}
+	mustPanic(t, "Windows(0, 1)", func() { Windows(0, 1, in) })
+	mustPanic(t, "Windows(1, 0)", func() { Windows(1, 0, in) })
+}
+
+func TestFrequencies(t *testing.T) {
+	got := Frequencies([]string{"a", "b", "a", "c", "a"})
+	if want := map[string]int{"a": 3, "b": 1, "c": 1}; !maps.Equal(got, want) {
+		t.Errorf("Frequencies got %v, want %v", got, want)
+	}
+	if got := Frequencies([]string{}); got == nil || len(got) != 0 {
+		t.Errorf("Frequencies of empty array got %#v", got)
+	}
+	byLen := FrequenciesBy(func(s string) int { return len(s) }, []string{"go", "c", "rust", "js"})
+	if want := map[int]int{1: 1, 2: 2, 4: 1}; !maps.Equal(byLen, want) {
+		t.Errorf("FrequenciesBy got %v, want %v", byLen, want)
+	}
+}
+
+func TestAssociate(t *testing.T) {
+	words := []string{"go", "rust", "gleam"}
+	got := Associate(func(s string) (string, int) { return s[:1], len(s) }, words)
+	// later elements win
+	if want := map[string]int{"g": 5, "r": 4}; !maps.Equal(got, want) {
+		t.Errorf("Associate got %v, want %v", got, want)
+	}
+	byFirst := KeyBy(func(s string) byte { return s[0] }, words)
+	if want := map[byte]string{'g': "gleam", 'r': "rust"}; !maps.Equal(byFirst, want) {
+		t.Errorf("KeyBy got %v, want %v", byFirst, want)
+	}
+	if got := KeyBy(func(s string) string { return s }, nil); got == nil || len(got) != 0 {
+		t.Errorf("KeyBy of empty array got %#v", got)
+	}
+}
@@ -0,0 +1,38 @@
+package sliceops
+
+import (
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// map, passing the index of each element to f
+func MapWithIndex[A, B any](f func(int, A) B, from []A) []B {
+	to := make([]B, len(from))
+	for i, v := range from {
+		to[i] = f(i, v)
+	}
+	return to
+}
+
+// filter, passing the index of each element to f
+func FilterWithIndex[A any](f func(int, A) bool, from []A) []A {
+	to := make([]A, 0)
+	for i, v := range from {
+		if f(i, v) {
+			to = append(to, v)
+		}
+	}
+	return to
+}
+
+// foldl, passing the index of each element to f
+func FoldWithIndex[A, B any](f func(B, int, A) B, z B, xs []A) B {
+	for i, x := range xs {
+		z = f(z, i, x)
+	}
+	return z
+}
+
+// pair each element of array with its index
+func Enumerate[A any](in []A) []hof.Pair[int, A] {
+	return MapWithIndex(hof.MakePair[int, A], in)
+}
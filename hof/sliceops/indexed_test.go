@@ -0,0 +1,41 @@
+package sliceops
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+func TestWithIndex(t *testing.T) {
+	in := []string{"a", "b", "c"}
+	mapped := MapWithIndex(func(i int, s string) string { return strconv.Itoa(i) + s }, in)
+	if want := []string{"0a", "1b", "2c"}; !slices.Equal(mapped, want) {
+		t.Errorf("MapWithIndex got %v, want %v", mapped, want)
+	}
+	odd := FilterWithIndex(func(i int, _ string) bool { return i%2 == 1 }, in)
+	if want := []string{"b"}; !slices.Equal(odd, want) {
+		t.Errorf("FilterWithIndex got %v, want %v", odd, want)
+	}
+	folded := FoldWithIndex(func(z string, i int, s string) string { return z + strconv.Itoa(i) + s }, ">", in)
+	if want := ">0a1b2c"; folded != want {
+		t.Errorf("FoldWithIndex got %q, want %q", folded, want)
+	}
+	if got := MapWithIndex(func(i int, s string) int { return i }, nil); len(got) != 0 {
+		t.Errorf("MapWithIndex of empty array got %v", got)
+	}
+	if got := FilterWithIndex(func(int, string) bool { return true }, nil); got == nil || len(got) != 0 {
+		t.Errorf("FilterWithIndex of empty array got %#v, want empty non-nil", got)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := Enumerate([]string{"a", "b"})
+	if want := []hof.Pair[int, string]{hof.MakePair(0, "a"), hof.MakePair(1, "b")}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := Enumerate([]string{}); len(got) != 0 {
+		t.Errorf("empty array got %v", got)
+	}
+}
@@ -0,0 +1,118 @@
+package sliceops
+
+import (
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// sum of array of numbers, 0 for an empty array
+func Sum[N hof.Number](in []N) N {
+	var sum N
+	for _, v := range in {
+		sum += v
+	}
+	return sum
+}
+
+// product of array of numbers, 1 for an empty array
+func Product[N hof.Number](in []N) N {
+	var product N = 1
+	for _, v := range in {
+		product *= v
+	}
+	return product
+}
+
+// arithmetic mean of array of numbers, false for an empty array
+func Mean[N hof.Number](in []N) (float64, bool) {
+	if len(in) == 0 {
+		return 0, false
+	}
+	return float64(Sum(in)) / float64(len(in)), true
+}
+
+// smallest element of array, false for an empty array
+func Min[O hof.Ordered](in []O) (O, bool) {
+	var least O
+	if len(in) == 0 {
+		return least, false
+	}
+	least = in[0]
+	for _, v := range in[1:] {
+		if v < least {
+			least = v
+		}
+	}
+	return least, true
+}
+
+// largest element of array, false for an empty array
+func Max[O hof.Ordered](in []O) (O, bool) {
+	var most O
+	if len(in) == 0 {
+		return most, false
+	}
+	most = in[0]
+	for _, v := range in[1:] {
+		if v > most {
+			most = v
+		}
+	}
+	return most, true
+}
+
+// smallest and largest elements of array in a single pass, false for an
+// empty array
+func MinMax[O hof.Ordered](in []O) (least, most O, ok bool) {
+	if len(in) == 0 {
+		return least, most, false
+	}
+	least, most = in[0], in[0]
+	for _, v := range in[1:] {
+		if v < least {
+			least = v
+		} else if v > most {
+			most = v
+		}
+	}
+	return least, most, true
+}
+
+// smallest element of array ordered by less, the first one on ties, false for
+// an empty array
+func MinBy[A any](less func(a, b A) bool, in []A) (A, bool) {
+	var least A
+	if len(in) == 0 {
+		return least, false
+	}
+	least = in[0]
+	for _, v := range in[1:] {
+		if less(v, least) {
+			least = v
+		}
+	}
+	return least, true
+}
+
+// largest element of array ordered by less, the first one on ties, false for
+// an empty array
+func MaxBy[A any](less func(a, b A) bool, in []A) (A, bool) {
+	var most A
+	if len(in) == 0 {
+		return most, false
+	}
+	most = in[0]
+	for _, v := range in[1:] {
+		if less(most, v) {
+			most = v
+		}
+	}
+	return most, true
+}
+
+// differences between consecutive elements of array, in[i+1] - in[i]
+func AdjacentDiff[N hof.Number](in []N) []N {
+	if len(in) < 2 {
+		return make([]N, 0)
+	}
+	return ZipWith(func(a, b N) N { return b - a }, in, in[1:])
+}
@@ -0,0 +1,126 @@
+package sliceops
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSumMean(t *testing.T) {
+	tests := []struct {
+		in   []int
+		sum  int
+		mean float64
+		ok   bool
+	}{
+		{nil, 0, 0, false},
+		{[]int{4}, 4, 4, true},
+		{[]int{1, 2, 3, 4}, 10, 2.5, true},
+	}
+	for _, tt := range tests {
+		if got := Sum(tt.in); got != tt.sum {
+			t.Errorf("Sum(%v) got %d, want %d", tt.in, got, tt.sum)
+		}
+		if got, ok := Mean(tt.in); got != tt.mean || ok != tt.ok {
+			t.Errorf("Mean(%v) got %v %v, want %v %v", tt.in, got, ok, tt.mean, tt.ok)
+		}
+	}
+	if got := Sum([]float64{0.5, 0.25}); got != 0.75 {
+		t.Errorf("Sum of floats got %v, want 0.75", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		in       []int
+		min, max int
+		ok       bool
+	}{
+		{nil, 0, 0, false},
+		{[]int{3}, 3, 3, true},
+		{[]int{3, -1, 7, 2}, -1, 7, true},
+	}
+	for _, tt := range tests {
+		if got, ok := Min(tt.in); got != tt.min || ok != tt.ok {
+			t.Errorf("Min(%v) got %d %v, want %d %v", tt.in, got, ok, tt.min, tt.ok)
+		}
+		if got, ok := Max(tt.in); got != tt.max || ok != tt.ok {
+			t.Errorf("Max(%v) got %d %v, want %d %v", tt.in, got, ok, tt.max, tt.ok)
+		}
+	}
+	if got, _ := Min([]string{"pear", "apple", "fig"}); got != "apple" {
+		t.Errorf("Min of strings got %q, want apple", got)
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	byLen := func(a, b string) bool { return len(a) < len(b) }
+	tests := []struct {
+		in    []string
+		least string
+		most  string
+		ok    bool
+	}{
+		{nil, "", "", false},
+		{[]string{"x"}, "x", "x", true},
+		// the first of equal elements wins
+		{[]string{"bb", "a", "ccc", "d", "eee"}, "a", "ccc", true},
+	}
+	for _, tt := range tests {
+		if got, ok := MinBy(byLen, tt.in); got != tt.least || ok != tt.ok {
+			t.Errorf("MinBy(%v) got %q %v, want %q %v", tt.in, got, ok, tt.least, tt.ok)
+		}
+		if got, ok := MaxBy(byLen, tt.in); got != tt.most || ok != tt.ok {
+			t.Errorf("MaxBy(%v) got %q %v, want %q %v", tt.in, got, ok, tt.most, tt.ok)
+		}
+	}
+}
+
+func TestMinMaxSinglePass(t *testing.T) {
+	tests := []struct {
+		in          []int
+		least, most int
+		ok          bool
+	}{
+		{nil, 0, 0, false},
+		{[]int{4}, 4, 4, true},
+		{[]int{3, 9, -2, 5}, -2, 9, true},
+		{[]int{5, 4, 3}, 3, 5, true},
+	}
+	for _, tt := range tests {
+		if least, most, ok := MinMax(tt.in); least != tt.least || most != tt.most || ok != tt.ok {
+			t.Errorf("MinMax(%v) got %d %d %v, want %d %d %v", tt.in, least, most, ok, tt.least, tt.most, tt.ok)
+		}
+	}
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		in   []int
+		want int
+	}{
+		{nil, 1},
+		{[]int{7}, 7},
+		{[]int{1, 2, 3, 4}, 24},
+		{[]int{3, 0, 5}, 0},
+	}
+	for _, tt := range tests {
+		if got := Product(tt.in); got != tt.want {
+			t.Errorf("Product(%v) got %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAdjacentDiff(t *testing.T) {
+	tests := []struct {
+		in, want []int
+	}{
+		{nil, []int{}},
+		{[]int{4}, []int{}},
+		{[]int{1, 4, 2, 2}, []int{3, -2, 0}},
+	}
+	for _, tt := range tests {
+		if got := AdjacentDiff(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("AdjacentDiff(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
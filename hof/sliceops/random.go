@@ -0,0 +1,39 @@
+package sliceops
+
+import (
+	"math/rand/v2"
+)
+
+// return shuffled copy of array. Pass a seeded r for reproducible results, or
+// nil to use the global random source.
+func Shuffle[A any](in []A, r *rand.Rand) []A {
+	out := append([]A(nil), in...)
+	swap := func(i, j int) { out[i], out[j] = out[j], out[i] }
+	if r == nil {
+		rand.Shuffle(len(out), swap)
+	} else {
+		r.Shuffle(len(out), swap)
+	}
+	return out
+}
+
+// return k elements of array chosen at random without replacement, or all of
+// them in random order if k >= len(in). Pass a seeded r for reproducible
+// results, or nil to use the global random source.
+func Sample[A any](k int, in []A, r *rand.Rand) []A {
+	out := append([]A(nil), in...)
+	k = max(0, min(k, len(out)))
+	for i := 0; i < k; i++ {
+		j := i + intN(r, len(out)-i)
+		out[i], out[j] = out[j], out[i]
+	}
+	return out[:k:k]
+}
+
+// random int in [0, n) from r, or from the global source if r is nil
+func intN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
@@ -0,0 +1,62 @@
+package sliceops
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	got := Shuffle(in, rand.New(rand.NewPCG(1, 2)))
+	if !slices.Equal(slices.Sorted(slices.Values(got)), in) {
+		t.Errorf("got %v, want a permutation of %v", got, in)
+	}
+	if !slices.Equal(in, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Shuffle changed its input: %v", in)
+	}
+	again := Shuffle(in, rand.New(rand.NewPCG(1, 2)))
+	if !slices.Equal(got, again) {
+		t.Errorf("same seed gave %v and %v", got, again)
+	}
+	if got := Shuffle(in, nil); len(got) != len(in) {
+		t.Errorf("global source got %v", got)
+	}
+	if got := Shuffle([]int{}, nil); len(got) != 0 {
+		t.Errorf("Shuffle of empty array got %v", got)
+	}
+}
+
+func TestSample(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		k, want int
+	}{
+		{-1, 0},
+		{0, 0},
+		{2, 2},
+		{5, 5},
+		{9, 5},
+	}
+	for _, tt := range tests {
+		got := Sample(tt.k, in, rand.New(rand.NewPCG(1, 2)))
+		if len(got) != tt.want {
+			t.Errorf("k %d got %v, want %d elements", tt.k, got, tt.want)
+		}
+		seen := map[int]bool{}
+		for _, v := range got {
+			if !slices.Contains(in, v) || seen[v] {
+				t.Errorf("k %d got %v, want distinct elements of the input", tt.k, got)
+			}
+			seen[v] = true
+		}
+	}
+	if !slices.Equal(in, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Sample changed its input: %v", in)
+	}
+	// the result has no spare capacity, so appending cannot clobber the rest
+	got := Sample(2, in, nil)
+	if cap(got) != 2 {
+		t.Errorf("cap %d, want 2", cap(got))
+	}
+}
@@ -0,0 +1,87 @@
+package sliceops
+
+import (
+	"sort"
+)
+
+// first element of array that satisfies f, false if there is none
+func Find[A any](f func(A) bool, in []A) (A, bool) {
+	if i := FindIndex(f, in); i >= 0 {
+		return in[i], true
+	}
+	var zero A
+	return zero, false
+}
+
+// index of the first element of array that satisfies f, -1 if there is none
+func FindIndex[A any](f func(A) bool, in []A) int {
+	for i, v := range in {
+		if f(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// last element of array that satisfies f, false if there is none
+func FindLast[A any](f func(A) bool, in []A) (A, bool) {
+	if i := FindLastIndex(f, in); i >= 0 {
+		return in[i], true
+	}
+	var zero A
+	return zero, false
+}
+
+// index of the last element of array that satisfies f, -1 if there is none
+func FindLastIndex[A any](f func(A) bool, in []A) int {
+	for i := len(in) - 1; i >= 0; i-- {
+		if f(in[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// true if any element of array satisfies f, false for an empty array
+func Any[A any](f func(A) bool, in []A) bool {
+	return FindIndex(f, in) >= 0
+}
+
+// true if every element of array satisfies f, true for an empty array
+func All[A any](f func(A) bool, in []A) bool {
+	for _, v := range in {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// true if no element of array satisfies f, true for an empty array
+func None[A any](f func(A) bool, in []A) bool {
+	return !Any(f, in)
+}
+
+// true if x is an element of array
+func Contains[A comparable](x A, in []A) bool {
+	for _, v := range in {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+// true if any element of array satisfies f
+func ContainsBy[A any](f func(A) bool, in []A) bool {
+	return Any(f, in)
+}
+
+// index of the first element of a sorted array that satisfies f, in
+// O(log n). f must be false for some prefix of the array and true for the
+// rest, as with sort.Search. Returns len(in) and false if no element satisfies
+// f.
+func BinarySearchBy[A any](f func(A) bool, in []A) (int, bool) {
+	i := sort.Search(len(in), func(i int) bool { return f(in[i]) })
+	return i, i < len(in)
+}
@@ -0,0 +1,136 @@
+package sliceops
+
+import (
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	gt := func(n int) func(int) bool { return func(i int) bool { return i > n } }
+	in := []int{1, 5, 2, 7}
+	tests := []struct {
+		n     int
+		v, i  int
+		found bool
+	}{
+		{0, 1, 0, true},
+		{4, 5, 1, true},
+		{6, 7, 3, true},
+		{9, 0, -1, false},
+	}
+	for _, tt := range tests {
+		if v, ok := Find(gt(tt.n), in); v != tt.v || ok != tt.found {
+			t.Errorf("Find(> %d) got %d %v, want %d %v", tt.n, v, ok, tt.v, tt.found)
+		}
+		if i := FindIndex(gt(tt.n), in); i != tt.i {
+			t.Errorf("FindIndex(> %d) got %d, want %d", tt.n, i, tt.i)
+		}
+	}
+	if _, ok := Find(gt(0), nil); ok {
+		t.Error("Find in empty array reported ok")
+	}
+}
+
+func TestFindLast(t *testing.T) {
+	gt := func(n int) func(int) bool { return func(i int) bool { return i > n } }
+	in := []int{7, 5, 2, 1}
+	tests := []struct {
+		n     int
+		v, i  int
+		found bool
+	}{
+		{0, 1, 3, true},
+		{4, 5, 1, true},
+		{6, 7, 0, true},
+		{9, 0, -1, false},
+	}
+	for _, tt := range tests {
+		if v, ok := FindLast(gt(tt.n), in); v != tt.v || ok != tt.found {
+			t.Errorf("FindLast(> %d) got %d %v, want %d %v", tt.n, v, ok, tt.v, tt.found)
+		}
+		if i := FindLastIndex(gt(tt.n), in); i != tt.i {
+			t.Errorf("FindLastIndex(> %d) got %d, want %d", tt.n, i, tt.i)
+		}
+	}
+}
+
+func TestAnyAllNone(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		in            []int
+		any, all, non bool
+	}{
+		{nil, false, true, true},
+		{[]int{1, 3}, false, false, true},
+		{[]int{1, 2}, true, false, false},
+		{[]int{2, 4}, true, true, false},
+	}
+	for _, tt := range tests {
+		if got := Any(even, tt.in); got != tt.any {
+			t.Errorf("Any(%v) got %v", tt.in, got)
+		}
+		if got := All(even, tt.in); got != tt.all {
+			t.Errorf("All(%v) got %v", tt.in, got)
+		}
+		if got := None(even, tt.in); got != tt.non {
+			t.Errorf("None(%v) got %v", tt.in, got)
+		}
+	}
+}
+
+func TestAnyAllShortCircuit(t *testing.T) {
+	calls := 0
+	even := func(i int) bool { calls++; return i%2 == 0 }
+	Any(even, []int{1, 2, 3, 4})
+	if calls != 2 {
+		t.Errorf("Any called f %d times, want 2", calls)
+	}
+	calls = 0
+	All(even, []int{2, 3, 4})
+	if calls != 2 {
+		t.Errorf("All called f %d times, want 2", calls)
+	}
+}
+
+func TestContains(t *testing.T) {
+	in := []int{1, 5, 2}
+	tests := []struct {
+		in   []int
+		x    int
+		want bool
+	}{
+		{nil, 1, false},
+		{in, 5, true},
+		{in, 3, false},
+	}
+	for _, tt := range tests {
+		if got := Contains(tt.x, tt.in); got != tt.want {
+			t.Errorf("Contains(%d, %v) got %v", tt.x, tt.in, got)
+		}
+		if got := ContainsBy(func(v int) bool { return v == tt.x }, tt.in); got != tt.want {
+			t.Errorf("ContainsBy(== %d, %v) got %v", tt.x, tt.in, got)
+		}
+	}
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	in := []int{1, 3, 3, 5, 7}
+	tests := []struct {
+		x     int
+		i     int
+		found bool
+	}{
+		{0, 0, true},
+		{3, 1, true},
+		{4, 3, true},
+		{7, 4, true},
+		{8, 5, false},
+	}
+	for _, tt := range tests {
+		if i, ok := BinarySearchBy(func(v int) bool { return v >= tt.x }, in); i != tt.i || ok != tt.found {
+			t.Errorf(">= %d got %d %v, want %d %v", tt.x, i, ok, tt.i, tt.found)
+		}
+	}
+	if i, ok := BinarySearchBy(func(int) bool { return true }, nil); i != 0 || ok {
+		t.Errorf("empty array got %d %v", i, ok)
+	}
+}
@@ -0,0 +1,78 @@
+package sliceops
+
+// array without duplicates, keeping the first occurrence of each element
+func Unique[A comparable](in []A) []A {
+	return UniqueBy(func(v A) A { return v }, in)
+}
+
+// array without elements whose key was already seen, keeping the first
+// occurrence of each key
+func UniqueBy[A any, K comparable](key func(A) K, in []A) []A {
+	seen := make(map[K]struct{})
+	out := make([]A, 0)
+	for _, v := range in {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// array with runs of consecutive equal elements collapsed to one, like uniq(1)
+func DedupAdjacent[A comparable](in []A) []A {
+	out := make([]A, 0)
+	for i, v := range in {
+		if i > 0 && v == in[i-1] {
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+// elements of a that are not in b, without duplicates, in the order they
+// first appear in a
+func Difference[A comparable](a, b []A) []A {
+	return distinctWhere(a, toSet(b), false)
+}
+
+// elements of a that are also in b, without duplicates, in the order they
+// first appear in a
+func Intersection[A comparable](a, b []A) []A {
+	return distinctWhere(a, toSet(b), true)
+}
+
+// elements of a or b, without duplicates, in the order they first appear in
+// a then b
+func Union[A comparable](a, b []A) []A {
+	return Unique(Concat(a, b))
+}
+
+// set of the elements of array
+func toSet[A comparable](in []A) map[A]struct{} {
+	set := make(map[A]struct{}, len(in))
+	for _, v := range in {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// first occurrence of each element of in whose membership in set is member
+func distinctWhere[A comparable](in []A, set map[A]struct{}, member bool) []A {
+	seen := make(map[A]struct{})
+	out := make([]A, 0)
+	for _, v := range in {
+		if _, ok := set[v]; ok != member {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+package sliceops
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		in, want []int
+	}{
+		{nil, []int{}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{3, 1, 3, 2, 1}, []int{3, 1, 2}},
+	}
+	for _, tt := range tests {
+		if got := Unique(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("Unique(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	got := UniqueBy(strings.ToLower, []string{"Go", "go", "GO", "Rust", "rust"})
+	if want := []string{"Go", "Rust"}; !slices.Equal(got, want) {
+		t.Errorf("UniqueBy got %v, want %v", got, want)
+	}
+}
+
+func TestDedupAdjacent(t *testing.T) {
+	tests := []struct {
+		in, want []int
+	}{
+		{nil, []int{}},
+		{[]int{1, 1, 1}, []int{1}},
+		{[]int{1, 1, 2, 1, 3, 3}, []int{1, 2, 1, 3}},
+	}
+	for _, tt := range tests {
+		if got := DedupAdjacent(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("DedupAdjacent(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	tests := []struct {
+		a, b                         []int
+		difference, intersect, union []int
+	}{
+		{nil, nil, []int{}, []int{}, []int{}},
+		{[]int{1, 2}, nil, []int{1, 2}, []int{}, []int{1, 2}},
+		{nil, []int{1, 2}, []int{}, []int{}, []int{1, 2}},
+		{[]int{3, 1, 3, 2, 4}, []int{4, 5, 3}, []int{1, 2}, []int{3, 4}, []int{3, 1, 2, 4, 5}},
+	}
+	for _, tt := range tests {
+		if got := Difference(tt.a, tt.b); !slices.Equal(got, tt.difference) {
+			t.Errorf("Difference(%v, %v) got %v, want %v", tt.a, tt.b, got, tt.difference)
+		}
+		if got := Intersection(tt.a, tt.b); !slices.Equal(got, tt.intersect) {
+			t.Errorf("Intersection(%v, %v) got %v, want %v", tt.a, tt.b, got, tt.intersect)
+		}
+		if got := Union(tt.a, tt.b); !slices.Equal(got, tt.union) {
+			t.Errorf("Union(%v, %v) got %v, want %v", tt.a, tt.b, got, tt.union)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+// Package sliceops provides higher order functions that work with arrays.
+package sliceops
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// return reversed copy of array of T
+func Reverse(in []hof.T) []hof.T {
+	l := len(in)
+	out := make([]hof.T, l)
+	for i, v := range in {
+		out[(l-1)-i] = v
+	}
+	return out
+}
+
+// reverse array in place, without allocating
+func ReverseInPlace[A any](in []A) {
+	for i, j := 0, len(in)-1; i < j; i, j = i+1, j-1 {
+		in[i], in[j] = in[j], in[i]
+	}
+}
+
+// return copy of array rotated left by n, or right for negative n.
+// n is taken modulo the length of the array.
+func Rotate[A any](n int, in []A) []A {
+	l := len(in)
+	out := make([]A, l)
+	if l == 0 {
+		return out
+	}
+	n = ((n % l) + l) % l
+	copy(out, in[n:])
+	copy(out[l-n:], in[:n])
+	return out
+}
+
+// map
+func MapT(f func(hof.T) hof.T, from []hof.T) []hof.T {
+	to := make([]hof.T, len(from))
+	for i, v := range from {
+		to[i] = f(v)
+	}
+	return to
+}
+
+// map from type A to type B
+func Map[A, B any](f func(A) B, from []A) []B {
+	to := make([]B, len(from))
+	for i, v := range from {
+		to[i] = f(v)
+	}
+	return to
+}
+
+// map each element to an array and concatenate the results
+func FlatMap[A, B any](f func(A) []B, from []A) []B {
+	to := make([]B, 0)
+	for _, v := range from {
+		to = append(to, f(v)...)
+	}
+	return to
+}
+
+// concatenate an array of arrays into one array
+func Flatten[A any](in [][]A) []A {
+	n := 0
+	for _, xs := range in {
+		n += len(xs)
+	}
+	out := make([]A, 0, n)
+	for _, xs := range in {
+		out = append(out, xs...)
+	}
+	return out
+}
+
+// concatenate an array of arrays of arrays into one array
+func FlattenDeep[A any](in [][][]A) []A {
+	return Flatten(Flatten(in))
+}
+
+// parallel map, one goroutine per element. See PmapN for large arrays.
+func PmapT(f func(hof.T) hof.T, from []hof.T) []hof.T {
+	N := len(from)
+	to := make([]hof.T, N)
+	var wg sync.WaitGroup
+	wg.Add(N)
+	for i, v := range from {
+		go func(i int, v hof.T) {
+			defer wg.Done()
+			to[i] = f(v)
+		}(i, v)
+	}
+	wg.Wait()
+	return to
+}
+
+// parallel map on a fixed pool of workers, each pulling the next index from
+// a shared counter, so the number of goroutines stays bounded however large
+// the array. Order is preserved. Panics if workers is not positive.
+func PmapN[A, B any](workers int, f func(A) B, from []A) []B {
+	if workers <= 0 {
+		panic("sliceops: PmapN workers must be positive")
+	}
+	to := make([]B, len(from))
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	workers = min(workers, len(from))
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(from) {
+					return
+				}
+				to[i] = f(from[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return to
+}
+
+// filter
+func FilterT(f func(hof.T) bool, from []hof.T) []hof.T {
+	to := make([]hof.T, 0)
+	for _, v := range from {
+		if f(v) {
+			to = append(to, v)
+		}
+	}
+	return to
+}
+
+func RemoveT(f func(hof.T) bool, from []hof.T) []hof.T {
+	to := make([]hof.T, 0)
+	for _, n := range from {
+		if !f(n) {
+			to = append(to, n)
+		}
+	}
+	return to
+}
+
+// array without zero valued elements
+func Compact[A comparable](from []A) []A {
+	var zero A
+	return CompactBy(func(v A) bool { return v == zero }, from)
+}
+
+// array without the elements that isEmpty reports as empty
+func CompactBy[A any](isEmpty func(A) bool, from []A) []A {
+	to := make([]A, 0)
+	for _, v := range from {
+		if !isEmpty(v) {
+			to = append(to, v)
+		}
+	}
+	return to
+}
+
+// split array into the elements that satisfy f and those that don't, in one pass
+func Partition[A any](f func(A) bool, from []A) (yes []A, no []A) {
+	yes = make([]A, 0)
+	no = make([]A, 0)
+	for _, v := range from {
+		if f(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+func Take(n int, from []hof.T) []hof.T {
+	to := make([]hof.T, 0)
+	for _, v := range from {
+		if n <= 0 {
+			break
+		}
+		to = append(to, v)
+		n -= 1
+	}
+	return to
+}
+
+func Drop(n int, from []hof.T) []hof.T {
+	to := make([]hof.T, 0)
+	for _, v := range from {
+		if n > 0 {
+			n -= 1
+			continue
+		}
+		to = append(to, v)
+	}
+	return to
+}
+
+// takeWhile :: (a -> Bool) -> [a] -> [a]
+func TakeWhile[A any](f func(A) bool, from []A) []A {
+	to := make([]A, 0)
+	for _, v := range from {
+		if !f(v) {
+			break
+		}
+		to = append(to, v)
+	}
+	return to
+}
+
+// dropWhile :: (a -> Bool) -> [a] -> [a]
+func DropWhile[A any](f func(A) bool, from []A) []A {
+	i := 0
+	for i < len(from) && f(from[i]) {
+		i++
+	}
+	to := make([]A, len(from)-i)
+	copy(to, from[i:])
+	return to
+}
+
+// span :: (a -> Bool) -> [a] -> ([a], [a])
+// span p xs = (takeWhile p xs, dropWhile p xs)
+func Span[A any](f func(A) bool, from []A) ([]A, []A) {
+	i := 0
+	for i < len(from) && f(from[i]) {
+		i++
+	}
+	return splitCopy(i, from)
+}
+
+// break :: (a -> Bool) -> [a] -> ([a], [a])
+// break p = span (not . p)
+func Break[A any](f func(A) bool, from []A) ([]A, []A) {
+	return Span(func(v A) bool { return !f(v) }, from)
+}
+
+// splitAt :: Int -> [a] -> ([a], [a])
+// splitAt n xs = (take n xs, drop n xs)
+func SplitAt[A any](n int, from []A) ([]A, []A) {
+	return splitCopy(max(0, min(n, len(from))), from)
+}
+
+// copy from[:i] and from[i:] into two new arrays
+func splitCopy[A any](i int, from []A) ([]A, []A) {
+	head := make([]A, i)
+	copy(head, from[:i])
+	tail := make([]A, len(from)-i)
+	copy(tail, from[i:])
+	return head, tail
+}
+
+// foldl :: (b -> a -> b) -> b -> [a] -> b
+// foldl f z []     = z
+// foldl f z (x:xs) = foldl f (f z x) xs
+func FoldlT(f func(hof.T, hof.T) hof.T, z hof.T, xs []hof.T) hof.T {
+	for _, x := range xs {
+		z = f(z, x)
+	}
+	return z
+}
+
+// foldr :: (a -> b -> b) -> b -> [a] -> b
+// foldr f z []     = z
+// foldr f z (x:xs) = f x (foldr f z xs)
+func FoldrT(f func(hof.T, hof.T) hof.T, z hof.T, xs []hof.T) hof.T {
+	for i := len(xs) - 1; i >= 0; i-- {
+		z = f(xs[i], z)
+	}
+	return z
+}
+
+// foldl with an accumulator of type B over elements of type A
+func Foldl[A, B any](f func(B, A) B, z B, xs []A) B {
+	for _, x := range xs {
+		z = f(z, x)
+	}
+	return z
+}
+
+// foldr with an accumulator of type B over elements of type A
+func Foldr[A, B any](f func(A, B) B, z B, xs []A) B {
+	for i := len(xs) - 1; i >= 0; i-- {
+		z = f(xs[i], z)
+	}
+	return z
+}
+
+// scanl :: (b -> a -> b) -> b -> [a] -> [b]
+// scanl f z xs = [z, f z x1, f (f z x1) x2, ...]
+func Scanl[A, B any](f func(B, A) B, z B, xs []A) []B {
+	out := make([]B, len(xs)+1)
+	out[0] = z
+	for i, x := range xs {
+		z = f(z, x)
+		out[i+1] = z
+	}
+	return out
+}
+
+// scanr :: (a -> b -> b) -> b -> [a] -> [b]
+// scanr f z xs = [..., f x(n-1) (f xn z), f xn z, z]
+func Scanr[A, B any](f func(A, B) B, z B, xs []A) []B {
+	out := make([]B, len(xs)+1)
+	out[len(xs)] = z
+	for i := len(xs) - 1; i >= 0; i-- {
+		z = f(xs[i], z)
+		out[i] = z
+	}
+	return out
+}
+
+// foldl1 :: (a -> a -> a) -> [a] -> a
+// reduce uses the first element as the seed, false for an empty array
+func Reduce[A any](f func(A, A) A, xs []A) (A, bool) {
+	if len(xs) == 0 {
+		var zero A
+		return zero, false
+	}
+	return Foldl(f, xs[0], xs[1:]), true
+}
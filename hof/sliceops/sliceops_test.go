@@ -0,0 +1,408 @@
+package sliceops
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		in, want []hof.T
+	}{
+		{[]hof.T{}, []hof.T{}},
+		{[]hof.T{1}, []hof.T{1}},
+		{[]hof.T{1, 2, 3}, []hof.T{3, 2, 1}},
+	}
+	for _, tt := range tests {
+		if got := Reverse(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("Reverse(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMapT(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []hof.T
+		want []hof.T
+	}{
+		{"empty", []hof.T{}, []hof.T{}},
+		{"double", []hof.T{1, 2, 3}, []hof.T{2, 4, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapT(func(i hof.T) hof.T { return i * 2 }, tt.in); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPmapT(t *testing.T) {
+	in := make([]hof.T, 100)
+	for i := range in {
+		in[i] = hof.T(i)
+	}
+	double := func(i hof.T) hof.T { return i * 2 }
+	if got, want := PmapT(double, in), MapT(double, in); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFilterAndRemove(t *testing.T) {
+	even := func(i hof.T) bool { return i%2 == 0 }
+	in := []hof.T{1, 2, 3, 4, 5}
+	if got, want := FilterT(even, in), []hof.T{2, 4}; !slices.Equal(got, want) {
+		t.Errorf("FilterT got %v, want %v", got, want)
+	}
+	if got, want := RemoveT(even, in), []hof.T{1, 3, 5}; !slices.Equal(got, want) {
+		t.Errorf("RemoveT got %v, want %v", got, want)
+	}
+}
+
+func TestTakeDrop(t *testing.T) {
+	in := []hof.T{1, 2, 3}
+	tests := []struct {
+		n          int
+		take, drop []hof.T
+	}{
+		{-1, []hof.T{}, []hof.T{1, 2, 3}},
+		{0, []hof.T{}, []hof.T{1, 2, 3}},
+		{2, []hof.T{1, 2}, []hof.T{3}},
+		{3, []hof.T{1, 2, 3}, []hof.T{}},
+		{5, []hof.T{1, 2, 3}, []hof.T{}},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.n), func(t *testing.T) {
+			if got := Take(tt.n, in); !slices.Equal(got, tt.take) {
+				t.Errorf("Take got %v, want %v", got, tt.take)
+			}
+			if got := Drop(tt.n, in); !slices.Equal(got, tt.drop) {
+				t.Errorf("Drop got %v, want %v", got, tt.drop)
+			}
+		})
+	}
+}
+
+func TestFolds(t *testing.T) {
+	sub := func(x, y hof.T) hof.T { return x - y }
+	in := []hof.T{1, 2, 3, 4}
+	tests := []struct {
+		name string
+		got  hof.T
+		want hof.T
+	}{
+		{"foldl sub", FoldlT(sub, 0, in), ((0 - 1 - 2) - 3) - 4},
+		{"foldr sub", FoldrT(sub, 0, in), 1 - (2 - (3 - (4 - 0)))},
+		{"foldl empty", FoldlT(sub, 7, nil), 7},
+		{"foldr empty", FoldrT(sub, 7, nil), 7},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	got := Map(strconv.Itoa, []int{1, 2, 3})
+	if want := []string{"1", "2", "3"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := Map(strconv.Itoa, nil); got == nil || len(got) != 0 {
+		t.Errorf("got %#v, want an empty array", got)
+	}
+}
+
+func TestGenericFolds(t *testing.T) {
+	join := Foldr(func(i int, s string) string { return strconv.Itoa(i) + s }, "", []int{1, 2, 3})
+	if join != "123" {
+		t.Errorf("Foldr join got %q, want 123", join)
+	}
+	count := Foldl(func(n int, s string) int { return n + len(s) }, 0, []string{"ab", "c"})
+	if count != 3 {
+		t.Errorf("Foldl count got %d, want 3", count)
+	}
+	if got := Foldl(func(n, i int) int { return n - i }, 10, nil); got != 10 {
+		t.Errorf("Foldl of empty array got %d, want the seed", got)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(int) []int
+		in   []int
+		want []int
+	}{
+		{"empty", func(i int) []int { return []int{i} }, nil, []int{}},
+		{"dup", func(i int) []int { return []int{i, i} }, []int{1, 2}, []int{1, 1, 2, 2}},
+		{"drop all", func(int) []int { return nil }, []int{1, 2}, []int{}},
+		{"ragged", func(i int) []int { return make([]int, i) }, []int{0, 1, 2}, []int{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		if got := FlatMap(tt.f, tt.in); got == nil || !slices.Equal(got, tt.want) {
+			t.Errorf("%s got %#v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		in   [][]int
+		want []int
+	}{
+		{nil, []int{}},
+		{[][]int{{}, {}}, []int{}},
+		{[][]int{{1}, {}, {2, 3}}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		if got := Flatten(tt.in); got == nil || !slices.Equal(got, tt.want) {
+			t.Errorf("Flatten(%v) got %#v, want %v", tt.in, got, tt.want)
+		}
+	}
+	if got, want := FlattenDeep([][][]int{{{1}, {2}}, {}, {{3, 4}}}), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("FlattenDeep got %v, want %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		in, yes, no []int
+	}{
+		{nil, []int{}, []int{}},
+		{[]int{1, 3}, []int{}, []int{1, 3}},
+		{[]int{1, 2, 3, 4}, []int{2, 4}, []int{1, 3}},
+	}
+	for _, tt := range tests {
+		yes, no := Partition(even, tt.in)
+		if !slices.Equal(yes, tt.yes) || !slices.Equal(no, tt.no) {
+			t.Errorf("Partition(%v) got %v %v, want %v %v", tt.in, yes, no, tt.yes, tt.no)
+		}
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	small := func(i int) bool { return i < 3 }
+	tests := []struct {
+		in, want []int
+	}{
+		{nil, []int{}},
+		{[]int{5, 1}, []int{}},
+		{[]int{1, 2, 3, 1}, []int{1, 2}},
+		{[]int{1, 2}, []int{1, 2}},
+	}
+	for _, tt := range tests {
+		if got := TakeWhile(small, tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("TakeWhile(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	small := func(i int) bool { return i < 3 }
+	tests := []struct {
+		in, want []int
+	}{
+		{nil, []int{}},
+		{[]int{5, 1}, []int{5, 1}},
+		{[]int{1, 2, 3, 1}, []int{3, 1}},
+		{[]int{1, 2}, []int{}},
+	}
+	for _, tt := range tests {
+		if got := DropWhile(small, tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("DropWhile(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	in := []int{5, 6}
+	DropWhile(small, in)[0] = 0
+	if in[0] != 5 {
+		t.Error("DropWhile result shares memory with its input")
+	}
+}
+
+func TestSpanBreak(t *testing.T) {
+	small := func(i int) bool { return i < 3 }
+	tests := []struct {
+		in                   []int
+		spanHead, spanTail   []int
+		breakHead, breakTail []int
+	}{
+		{nil, []int{}, []int{}, []int{}, []int{}},
+		{[]int{1, 2, 3, 1}, []int{1, 2}, []int{3, 1}, []int{}, []int{1, 2, 3, 1}},
+		{[]int{4, 1}, []int{}, []int{4, 1}, []int{4}, []int{1}},
+	}
+	for _, tt := range tests {
+		if h, tl := Span(small, tt.in); !slices.Equal(h, tt.spanHead) || !slices.Equal(tl, tt.spanTail) {
+			t.Errorf("Span(%v) got %v %v, want %v %v", tt.in, h, tl, tt.spanHead, tt.spanTail)
+		}
+		if h, tl := Break(small, tt.in); !slices.Equal(h, tt.breakHead) || !slices.Equal(tl, tt.breakTail) {
+			t.Errorf("Break(%v) got %v %v, want %v %v", tt.in, h, tl, tt.breakHead, tt.breakTail)
+		}
+	}
+	// the halves are copies, so appending to the head leaves the tail alone
+	in := []int{1, 5, 6}
+	head, tail := Span(small, in)
+	_ = append(head, 0)
+	if tail[0] != 5 || in[1] != 5 {
+		t.Errorf("append to head changed tail %v or input %v", tail, in)
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	in := []int{1, 2, 3}
+	tests := []struct {
+		n          int
+		head, tail []int
+	}{
+		{-1, []int{}, []int{1, 2, 3}},
+		{0, []int{}, []int{1, 2, 3}},
+		{1, []int{1}, []int{2, 3}},
+		{3, []int{1, 2, 3}, []int{}},
+		{9, []int{1, 2, 3}, []int{}},
+	}
+	for _, tt := range tests {
+		if h, tl := SplitAt(tt.n, in); !slices.Equal(h, tt.head) || !slices.Equal(tl, tt.tail) {
+			t.Errorf("SplitAt(%d) got %v %v, want %v %v", tt.n, h, tl, tt.head, tt.tail)
+		}
+	}
+}
+
+func TestScans(t *testing.T) {
+	add := func(x, y int) int { return x + y }
+	tests := []struct {
+		in           []int
+		scanl, scanr []int
+	}{
+		{nil, []int{0}, []int{0}},
+		{[]int{1, 2, 3}, []int{0, 1, 3, 6}, []int{6, 5, 3, 0}},
+	}
+	for _, tt := range tests {
+		if got := Scanl(add, 0, tt.in); !slices.Equal(got, tt.scanl) {
+			t.Errorf("Scanl(%v) got %v, want %v", tt.in, got, tt.scanl)
+		}
+		if got := Scanr(add, 0, tt.in); !slices.Equal(got, tt.scanr) {
+			t.Errorf("Scanr(%v) got %v, want %v", tt.in, got, tt.scanr)
+		}
+	}
+	// scanr is right-associative
+	sub := func(x, y int) int { return x - y }
+	if got, want := Scanr(sub, 0, []int{1, 2, 3}), []int{2, -1, 3, 0}; !slices.Equal(got, want) {
+		t.Errorf("Scanr sub got %v, want %v", got, want)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sub := func(x, y int) int { return x - y }
+	tests := []struct {
+		in   []int
+		want int
+		ok   bool
+	}{
+		{nil, 0, false},
+		{[]int{5}, 5, true},
+		{[]int{10, 2, 3}, 5, true},
+	}
+	for _, tt := range tests {
+		if got, ok := Reduce(sub, tt.in); got != tt.want || ok != tt.ok {
+			t.Errorf("Reduce(%v) got %d %v, want %d %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestReverseInPlace(t *testing.T) {
+	tests := []struct {
+		in, want []int
+	}{
+		{nil, nil},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2}, []int{2, 1}},
+		{[]int{1, 2, 3}, []int{3, 2, 1}},
+	}
+	for _, tt := range tests {
+		ReverseInPlace(tt.in)
+		if !slices.Equal(tt.in, tt.want) {
+			t.Errorf("got %v, want %v", tt.in, tt.want)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	in := []int{1, 2, 3, 4}
+	tests := []struct {
+		n    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4}},
+		{1, []int{2, 3, 4, 1}},
+		{-1, []int{4, 1, 2, 3}},
+		{4, []int{1, 2, 3, 4}},
+		{6, []int{3, 4, 1, 2}},
+		{-6, []int{3, 4, 1, 2}},
+	}
+	for _, tt := range tests {
+		if got := Rotate(tt.n, in); !slices.Equal(got, tt.want) {
+			t.Errorf("Rotate(%d) got %v, want %v", tt.n, got, tt.want)
+		}
+	}
+	if got := Rotate(3, []int{}); len(got) != 0 {
+		t.Errorf("Rotate of empty array got %v", got)
+	}
+	if !slices.Equal(in, []int{1, 2, 3, 4}) {
+		t.Errorf("Rotate changed its input: %v", in)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	if got, want := Compact([]string{"", "a", "", "b", ""}), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Compact got %q, want %q", got, want)
+	}
+	if got := Compact([]int(nil)); got == nil || len(got) != 0 {
+		t.Errorf("Compact of empty array got %#v, want empty non-nil", got)
+	}
+	blank := func(s string) bool { return strings.TrimSpace(s) == "" }
+	if got, want := CompactBy(blank, []string{" ", "a", "\t", "b"}), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("CompactBy got %q, want %q", got, want)
+	}
+}
+
+func TestFoldsLargeInput(t *testing.T) {
+	in := make([]hof.T, 1_000_000)
+	for i := range in {
+		in[i] = 1
+	}
+	add := func(x, y hof.T) hof.T { return x + y }
+	if got := FoldlT(add, 0, in); got != hof.T(len(in)) {
+		t.Errorf("FoldlT got %v, want %d", got, len(in))
+	}
+	if got := FoldrT(add, 0, in); got != hof.T(len(in)) {
+		t.Errorf("FoldrT got %v, want %d", got, len(in))
+	}
+}
+
+func TestPmapN(t *testing.T) {
+	in := Range(0, 1000, 1)
+	for _, workers := range []int{1, 3, 2000} {
+		got := PmapN(workers, func(i int) int { return i * i }, in)
+		if want := Map(func(i int) int { return i * i }, in); !slices.Equal(got, want) {
+			t.Errorf("workers %d: got %v, want %v", workers, got, want)
+		}
+	}
+	if got := PmapN(4, func(i int) int { return i }, nil); len(got) != 0 {
+		t.Errorf("got %v for an empty array", got)
+	}
+}
+
+func TestPmapNPanicsOnBadWorkers(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for 0 workers")
+		}
+	}()
+	PmapN(0, func(i int) int { return i }, []int{1})
+}
@@ -0,0 +1,20 @@
+package sliceops
+
+import (
+	"sort"
+)
+
+// return sorted copy of array, ordered by less
+func SortBy[A any](less func(a, b A) bool, in []A) []A {
+	out := append([]A(nil), in...)
+	sort.Slice(out, func(i, j int) bool { return less(out[i], out[j]) })
+	return out
+}
+
+// return sorted copy of array, ordered by less, keeping equal elements in
+// their original order
+func SortStableBy[A any](less func(a, b A) bool, in []A) []A {
+	out := append([]A(nil), in...)
+	sort.SliceStable(out, func(i, j int) bool { return less(out[i], out[j]) })
+	return out
+}
@@ -0,0 +1,32 @@
+package sliceops
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortBy(t *testing.T) {
+	in := []int{3, 1, 2}
+	got := SortBy(func(a, b int) bool { return a > b }, in)
+	if want := []int{3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if !slices.Equal(in, []int{3, 1, 2}) {
+		t.Errorf("SortBy changed its input: %v", in)
+	}
+	if got := SortBy(func(a, b int) bool { return a < b }, nil); len(got) != 0 {
+		t.Errorf("got %v for an empty array", got)
+	}
+}
+
+func TestSortStableBy(t *testing.T) {
+	byLen := func(a, b string) bool { return len(a) < len(b) }
+	in := []string{"bb", "a", "cc", "d", "aa"}
+	got := SortStableBy(byLen, in)
+	if want := []string{"a", "d", "bb", "cc", "aa"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if in[0] != "bb" {
+		t.Errorf("SortStableBy changed its input: %v", in)
+	}
+}
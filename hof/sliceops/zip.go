@@ -0,0 +1,66 @@
+package sliceops
+
+import (
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+// zip two arrays into an array of pairs, stopping at the shorter array
+func Zip[A, B any](a []A, b []B) []hof.Pair[A, B] {
+	return ZipWith(hof.MakePair[A, B], a, b)
+}
+
+// combine two arrays element-wise with f, stopping at the shorter array
+func ZipWith[A, B, C any](f func(A, B) C, a []A, b []B) []C {
+	n := min(len(a), len(b))
+	out := make([]C, n)
+	for i := 0; i < n; i++ {
+		out[i] = f(a[i], b[i])
+	}
+	return out
+}
+
+// zip three arrays into an array of triples, stopping at the shortest array
+func Zip3[A, B, C any](a []A, b []B, c []C) []hof.Triple[A, B, C] {
+	n := min(len(a), len(b), len(c))
+	out := make([]hof.Triple[A, B, C], n)
+	for i := 0; i < n; i++ {
+		out[i] = hof.MakeTriple(a[i], b[i], c[i])
+	}
+	return out
+}
+
+// zip two arrays into an array of pairs, padding the shorter array with
+// fillA or fillB so the result is as long as the longer array
+func ZipLongest[A, B any](a []A, b []B, fillA A, fillB B) []hof.Pair[A, B] {
+	n := max(len(a), len(b))
+	out := make([]hof.Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		x, y := fillA, fillB
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		out[i] = hof.MakePair(x, y)
+	}
+	return out
+}
+
+// split an array of pairs into two arrays, the inverse of zip
+func Unzip[A, B any](in []hof.Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(in))
+	b := make([]B, len(in))
+	for i, p := range in {
+		a[i], b[i] = p.First, p.Second
+	}
+	return a, b
+}
+
+// consecutive pairs of elements of array, one fewer than its length
+func Pairwise[A any](in []A) []hof.Pair[A, A] {
+	if len(in) < 2 {
+		return make([]hof.Pair[A, A], 0)
+	}
+	return Zip(in, in[1:])
+}
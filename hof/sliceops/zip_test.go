@@ -0,0 +1,108 @@
+package sliceops
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stuheiss/go-higher-order-functions/hof"
+)
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []string
+		want []hof.Pair[int, string]
+	}{
+		{"empty", nil, nil, []hof.Pair[int, string]{}},
+		{"same length", []int{1, 2}, []string{"a", "b"}, []hof.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}},
+		{"shorter a", []int{1}, []string{"a", "b"}, []hof.Pair[int, string]{{First: 1, Second: "a"}}},
+		{"shorter b", []int{1, 2}, nil, []hof.Pair[int, string]{}},
+	}
+	for _, tt := range tests {
+		if got := Zip(tt.a, tt.b); !slices.Equal(got, tt.want) {
+			t.Errorf("%s got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	tests := []struct {
+		a, b, want []int
+	}{
+		{nil, []int{1}, []int{}},
+		{[]int{1, 2, 3}, []int{10, 20}, []int{11, 22}},
+	}
+	for _, tt := range tests {
+		if got := ZipWith(add, tt.a, tt.b); !slices.Equal(got, tt.want) {
+			t.Errorf("ZipWith(%v, %v) got %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+	repeat := ZipWith(strings.Repeat, []string{"a", "b"}, []int{3, 1})
+	if want := []string{"aaa", "b"}; !slices.Equal(repeat, want) {
+		t.Errorf("ZipWith of mixed types got %v, want %v", repeat, want)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	got := Zip3([]int{1, 2, 3}, []string{"a", "b"}, []bool{true, false, true})
+	want := []hof.Triple[int, string, bool]{hof.MakeTriple(1, "a", true), hof.MakeTriple(2, "b", false)}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := Zip3([]int{}, []int{1}, []int{1}); len(got) != 0 {
+		t.Errorf("got %v, want nothing", got)
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []string
+		want []hof.Pair[int, string]
+	}{
+		{"empty", nil, nil, []hof.Pair[int, string]{}},
+		{"pads a", []int{1}, []string{"a", "b"}, []hof.Pair[int, string]{{First: 1, Second: "a"}, {First: -1, Second: "b"}}},
+		{"pads b", []int{1, 2}, []string{"a"}, []hof.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "?"}}},
+	}
+	for _, tt := range tests {
+		if got := ZipLongest(tt.a, tt.b, -1, "?"); !slices.Equal(got, tt.want) {
+			t.Errorf("%s got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	tests := []struct {
+		a []int
+		b []string
+	}{
+		{[]int{}, []string{}},
+		{[]int{1, 2, 3}, []string{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		a, b := Unzip(Zip(tt.a, tt.b))
+		if !slices.Equal(a, tt.a) || !slices.Equal(b, tt.b) {
+			t.Errorf("Unzip(Zip(%v, %v)) got %v %v", tt.a, tt.b, a, b)
+		}
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	tests := []struct {
+		in   []int
+		want []hof.Pair[int, int]
+	}{
+		{nil, []hof.Pair[int, int]{}},
+		{[]int{1}, []hof.Pair[int, int]{}},
+		{[]int{1, 2, 3}, []hof.Pair[int, int]{hof.MakePair(1, 2), hof.MakePair(2, 3)}},
+	}
+	for _, tt := range tests {
+		if got := Pairwise(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("Pairwise(%v) got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}